@@ -0,0 +1,311 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is a single archived log line.
+//
+// Entry는 보관된 로그 엔트리 하나입니다.
+type Entry struct {
+	Timestamp time.Time         `json:"ts"`
+	Level     string            `json:"level"`
+	Source    string            `json:"source,omitempty"`
+	Message   string            `json:"msg"`
+	Metadata  map[string]string `json:"meta,omitempty"`
+}
+
+// Manifest describes an archived worker log.
+//
+// Manifest는 보관된 Worker 로그의 메타데이터입니다.
+type Manifest struct {
+	WorkerID       string            `json:"worker_id"`
+	TaskID         string            `json:"task_id,omitempty"`
+	PipelineID     string            `json:"pipeline_id,omitempty"`
+	StageID        string            `json:"stage_id,omitempty"`
+	Namespace      string            `json:"namespace,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Lines          int64             `json:"lines"`
+	CompressedSize int64             `json:"compressed_size"`
+	FirstTimestamp time.Time         `json:"first_timestamp,omitempty"`
+	LastTimestamp  time.Time         `json:"last_timestamp,omitempty"`
+	ArchivedAt     time.Time         `json:"archived_at"`
+}
+
+// Archive stores compressed worker logs with task, pipeline and stage indexes.
+//
+// Archive는 Worker 로그를 압축하여 Store에 보관하고
+// Task, Pipeline, Stage 단위로 조회할 수 있도록 인덱스를 관리합니다.
+type Archive struct {
+	store Store
+}
+
+// New는 store를 사용하는 Archive를 생성합니다
+func New(store Store) *Archive {
+	return &Archive{store: store}
+}
+
+// Writer buffers a worker's log in a compressed temp file until Close uploads it.
+//
+// Writer는 Worker 하나의 로그를 임시 파일에 압축하여 쌓아두고,
+// Close 시 로그와 Manifest, 인덱스를 저장소에 업로드합니다.
+// 여러 컨테이너의 로그를 동시에 기록할 수 있도록 동시 호출에 안전합니다.
+type Writer struct {
+	archive  *Archive
+	manifest Manifest
+
+	mu      sync.Mutex
+	file    *os.File
+	gzip    *gzip.Writer
+	encoder *json.Encoder
+	err     error
+	closed  bool
+}
+
+// NewWriter는 Worker 로그를 기록할 Writer를 생성합니다
+func (a *Archive) NewWriter(manifest Manifest) (*Writer, error) {
+	if manifest.WorkerID == "" {
+		return nil, fmt.Errorf("worker id is required")
+	}
+
+	file, err := os.CreateTemp("", "ottoscaler-log-*.jsonl.gz")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log buffer: %w", err)
+	}
+
+	gz := gzip.NewWriter(file)
+	return &Writer{
+		archive:  a,
+		manifest: manifest,
+		file:     file,
+		gzip:     gz,
+		encoder:  json.NewEncoder(gz),
+	}, nil
+}
+
+// Write는 로그 엔트리를 기록합니다
+func (w *Writer) Write(entry Entry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return fmt.Errorf("archive writer for %s is closed", w.manifest.WorkerID)
+	}
+	if w.err != nil {
+		return w.err
+	}
+
+	if err := w.encoder.Encode(entry); err != nil {
+		w.err = err
+		return err
+	}
+
+	w.manifest.Lines++
+	if w.manifest.FirstTimestamp.IsZero() || entry.Timestamp.Before(w.manifest.FirstTimestamp) {
+		w.manifest.FirstTimestamp = entry.Timestamp
+	}
+	if entry.Timestamp.After(w.manifest.LastTimestamp) {
+		w.manifest.LastTimestamp = entry.Timestamp
+	}
+	return nil
+}
+
+// Close finishes compression and uploads the log, manifest and indexes.
+//
+// Close는 압축을 마무리하고 로그, Manifest, 인덱스를 업로드한 뒤 임시 파일을 삭제합니다.
+func (w *Writer) Close(ctx context.Context) (*Manifest, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil, fmt.Errorf("archive writer for %s is already closed", w.manifest.WorkerID)
+	}
+	w.closed = true
+	defer os.Remove(w.file.Name())
+	defer w.file.Close()
+
+	if w.err != nil {
+		return nil, w.err
+	}
+	if err := w.gzip.Close(); err != nil {
+		return nil, err
+	}
+
+	size, err := w.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	manifest := w.manifest
+	manifest.CompressedSize = size
+	manifest.ArchivedAt = time.Now()
+
+	store := w.archive.store
+	if err := store.Put(ctx, logKey(manifest.WorkerID), w.file, size); err != nil {
+		return nil, fmt.Errorf("failed to upload log of %s: %w", manifest.WorkerID, err)
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, manifestKey(manifest.WorkerID), bytes.NewReader(data), int64(len(data))); err != nil {
+		return nil, fmt.Errorf("failed to upload manifest of %s: %w", manifest.WorkerID, err)
+	}
+
+	for _, key := range indexKeys(manifest) {
+		if err := store.Put(ctx, key, strings.NewReader(manifest.WorkerID), int64(len(manifest.WorkerID))); err != nil {
+			return nil, fmt.Errorf("failed to index log of %s: %w", manifest.WorkerID, err)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// Abort는 업로드하지 않고 Writer를 정리합니다
+func (w *Writer) Abort() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+	w.closed = true
+	w.gzip.Close()
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// Manifest는 Worker 로그의 Manifest를 조회합니다 (없으면 ErrNotFound)
+func (a *Archive) Manifest(ctx context.Context, workerID string) (*Manifest, error) {
+	body, err := a.store.Get(ctx, manifestKey(workerID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest for %s: %w", workerID, err)
+	}
+	return &manifest, nil
+}
+
+// ReadEntries streams the archived entries of a worker in order.
+//
+// ReadEntries는 Worker의 보관된 로그를 순서대로 읽어 fn에 전달합니다.
+// fn이 false를 반환하면 읽기를 중단합니다.
+func (a *Archive) ReadEntries(ctx context.Context, workerID string, fn func(line int64, entry Entry) bool) error {
+	body, err := a.store.Get(ctx, logKey(workerID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("invalid log archive for %s: %w", workerID, err)
+	}
+	defer gz.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(gz))
+	for line := int64(0); ; line++ {
+		var entry Entry
+		if err := decoder.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("invalid log archive for %s: %w", workerID, err)
+		}
+		if !fn(line, entry) {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// WorkersForTask는 Task에 속한 보관된 Worker 목록을 반환합니다
+func (a *Archive) WorkersForTask(ctx context.Context, taskID string) ([]string, error) {
+	return a.listIndex(ctx, "index/task/"+escapeKey(taskID)+"/")
+}
+
+// WorkersForPipeline은 Pipeline(선택적으로 Stage)에 속한 보관된 Worker 목록을 반환합니다
+func (a *Archive) WorkersForPipeline(ctx context.Context, pipelineID, stageID string) ([]string, error) {
+	prefix := "index/pipeline/" + escapeKey(pipelineID) + "/"
+	if stageID != "" {
+		prefix += escapeKey(stageID) + "/"
+	}
+	return a.listIndex(ctx, prefix)
+}
+
+// listIndex는 인덱스 키의 마지막 경로 요소(Worker ID)를 모읍니다
+func (a *Archive) listIndex(ctx context.Context, prefix string) ([]string, error) {
+	keys, err := a.store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	workers := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		workerID, err := url.PathUnescape(path.Base(key))
+		if err != nil || seen[workerID] {
+			continue
+		}
+		seen[workerID] = true
+		workers = append(workers, workerID)
+	}
+	return workers, nil
+}
+
+// logKey, manifestKey, indexKeys는 저장소 키 규칙을 정의합니다
+func logKey(workerID string) string {
+	return "logs/" + escapeKey(workerID) + ".jsonl.gz"
+}
+
+func manifestKey(workerID string) string {
+	return "manifests/" + escapeKey(workerID) + ".json"
+}
+
+func indexKeys(manifest Manifest) []string {
+	var keys []string
+	worker := escapeKey(manifest.WorkerID)
+	if manifest.TaskID != "" {
+		keys = append(keys, "index/task/"+escapeKey(manifest.TaskID)+"/"+worker)
+	}
+	if manifest.PipelineID != "" {
+		stage := manifest.StageID
+		if stage == "" {
+			stage = "_"
+		}
+		keys = append(keys, "index/pipeline/"+escapeKey(manifest.PipelineID)+"/"+escapeKey(stage)+"/"+worker)
+	}
+	return keys
+}
+
+// escapeKey는 ID를 하나의 경로 요소로 안전하게 인코딩합니다
+func escapeKey(id string) string {
+	escaped := url.PathEscape(id)
+	if escaped == "." || escaped == ".." {
+		escaped = strings.ReplaceAll(escaped, ".", "%2E")
+	}
+	return escaped
+}
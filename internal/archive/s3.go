@@ -0,0 +1,299 @@
+package archive
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service         = "s3"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+)
+
+// S3Config configures an S3-compatible object store.
+//
+// S3Config는 S3 호환 오브젝트 스토리지(AWS S3, MinIO 등) 연결 설정입니다.
+type S3Config struct {
+	Endpoint  string // 예: "http://minio:9000", "https://s3.ap-northeast-2.amazonaws.com"
+	Region    string // 서명에 사용할 리전 (MinIO는 보통 "us-east-1")
+	Bucket    string
+	Prefix    string // 모든 키 앞에 붙일 경로 (선택적)
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store stores objects in an S3-compatible bucket using path-style requests.
+//
+// S3Store는 S3 호환 버킷에 오브젝트를 저장합니다.
+// MinIO와의 호환성을 위해 path-style 주소({endpoint}/{bucket}/{key})와
+// AWS Signature Version 4 서명을 사용합니다.
+type S3Store struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3Store는 S3 호환 저장소 클라이언트를 생성합니다
+func NewS3Store(config S3Config) (*S3Store, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket are required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	config.Prefix = strings.Trim(config.Prefix, "/")
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+
+	return &S3Store{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+// Put은 PutObject 요청으로 오브젝트를 업로드합니다
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	req, err := s.newRequest(ctx, http.MethodPut, s.objectPath(key), nil, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Get은 GetObject 요청으로 오브젝트를 다운로드합니다
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, s.objectPath(key), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// listBucketResult는 ListObjectsV2 응답 중 필요한 필드입니다
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List는 ListObjectsV2 요청을 반복하여 prefix로 시작하는 키를 모두 조회합니다
+func (s *S3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	fullPrefix := s.fullKey(prefix)
+
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", fullPrefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		req, err := s.newRequest(ctx, http.MethodGet, "/"+s.config.Bucket, query, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode s3 list response: %w", err)
+		}
+
+		for _, object := range result.Contents {
+			key := object.Key
+			if s.config.Prefix != "" {
+				key = strings.TrimPrefix(key, s.config.Prefix+"/")
+			}
+			keys = append(keys, key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// fullKey는 설정된 Prefix를 붙인 오브젝트 키를 반환합니다
+func (s *S3Store) fullKey(key string) string {
+	if s.config.Prefix == "" {
+		return key
+	}
+	return s.config.Prefix + "/" + key
+}
+
+// objectPath는 path-style 오브젝트 경로를 반환합니다
+func (s *S3Store) objectPath(key string) string {
+	return "/" + s.config.Bucket + "/" + s.fullKey(key)
+}
+
+// newRequest는 서명된 S3 요청을 생성합니다
+func (s *S3Store) newRequest(ctx context.Context, method, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	target := *s.endpoint
+	target.Path = strings.TrimRight(s.endpoint.Path, "/") + path
+	target.RawPath = ""
+	target.RawQuery = ""
+
+	// 서명과 실제 요청 경로가 일치하도록 직접 인코딩
+	canonicalURI := uriEncode(target.Path, false)
+	rawQuery := canonicalQuery(query)
+
+	requestURL := fmt.Sprintf("%s://%s%s", target.Scheme, target.Host, canonicalURI)
+	if rawQuery != "" {
+		requestURL += "?" + rawQuery
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sign(req, canonicalURI, rawQuery, time.Now().UTC())
+	return req, nil
+}
+
+// sign은 AWS Signature Version 4로 요청에 서명합니다
+func (s *S3Store) sign(req *http.Request, canonicalURI, rawQuery string, now time.Time) {
+	amzDate := now.Format(s3TimeFormat)
+	date := now.Format(s3DateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	if s.config.AccessKey == "" {
+		return // 익명 접근
+	}
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + s3UnsignedPayload + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		rawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/" + s3Service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKey, scope, signedHeaders, signature))
+}
+
+// do는 요청을 실행하고 실패 응답을 에러로 변환합니다
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, fmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(message)))
+}
+
+// hmacSHA256은 HMAC-SHA256 값을 계산합니다
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery는 SigV4 규칙(키 정렬, RFC 3986 인코딩)에 따라 쿼리 문자열을 만듭니다
+func canonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode는 RFC 3986 비예약 문자를 제외한 모든 바이트를 퍼센트 인코딩합니다
+func uriEncode(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9'),
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,147 @@
+// Package archive provides durable storage for worker logs.
+//
+// 이 패키지는 Worker Pod가 삭제된 뒤에도 로그를 조회할 수 있도록
+// 전체 로그를 압축하여 보관합니다. 저장소는 Store 인터페이스로 추상화되어
+// 로컬 파일시스템(LocalStore)과 S3 호환 오브젝트 스토리지(S3Store, MinIO 등)를 지원합니다.
+//
+// 저장 구조:
+//
+//	logs/<worker>.jsonl.gz                         로그 엔트리 (gzip 압축 JSON Lines)
+//	manifests/<worker>.json                        Worker 메타데이터와 라인 수
+//	index/task/<task>/<worker>                     Task별 인덱스
+//	index/pipeline/<pipeline>/<stage>/<worker>     Pipeline/Stage별 인덱스
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ErrNotFound는 요청한 오브젝트가 저장소에 없을 때 반환됩니다
+var ErrNotFound = errors.New("archive object not found")
+
+// Store is a minimal object store used by the log archive.
+//
+// Store는 로그 아카이브가 사용하는 오브젝트 저장소 인터페이스입니다.
+// 키는 '/'로 구분된 경로 형식입니다.
+type Store interface {
+	// Put은 오브젝트를 저장합니다 (같은 키가 있으면 덮어씀)
+	Put(ctx context.Context, key string, body io.Reader, size int64) error
+	// Get은 오브젝트를 읽습니다 (없으면 ErrNotFound)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// List는 prefix로 시작하는 키 목록을 정렬하여 반환합니다
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// LocalStore stores objects as files under a root directory.
+//
+// LocalStore는 루트 디렉토리 아래에 오브젝트를 파일로 저장합니다.
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore는 root 디렉토리를 사용하는 LocalStore를 생성합니다
+func NewLocalStore(root string) (*LocalStore, error) {
+	if root == "" {
+		return nil, fmt.Errorf("archive directory is required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory %s: %w", root, err)
+	}
+	return &LocalStore{root: root}, nil
+}
+
+// Put은 임시 파일에 쓴 뒤 rename하여 오브젝트를 원자적으로 저장합니다
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get은 오브젝트 파일을 엽니다
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// List는 prefix로 시작하는 모든 오브젝트 키를 반환합니다
+func (s *LocalStore) List(ctx context.Context, prefix string) ([]string, error) {
+	// prefix의 디렉토리 부분부터 탐색
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		var err error
+		if dir, err = s.path(prefix[:i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys []string
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".tmp-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// path는 키를 루트 디렉토리 아래의 파일 경로로 변환합니다
+func (s *LocalStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return s.root, nil
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
@@ -0,0 +1,384 @@
+// Package auth authenticates and authorizes callers of OttoscalerService.
+//
+// auth 패키지는 OttoscalerService 호출자를 인증하고 메서드/Repository 단위로 권한을 검사합니다.
+// 호출자는 gRPC 메타데이터 "authorization: Bearer <토큰>"으로 정적 토큰(Secret 파일) 또는
+// HMAC 서명 JWT를 보내며, 토큰의 역할(Role)에 따라 호출할 수 있는 메서드가 정해집니다.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultReloadInterval은 토큰 파일과 JWT 키 파일 변경을 확인하는 기본 간격입니다
+const DefaultReloadInterval = 10 * time.Second
+
+// Method is how a caller was authenticated.
+//
+// Method는 호출자를 인증한 방식입니다.
+type Method string
+
+const (
+	// MethodToken은 토큰 파일의 정적 Bearer 토큰입니다
+	MethodToken Method = "token"
+	// MethodJWT는 HMAC 서명 JWT입니다
+	MethodJWT Method = "jwt"
+)
+
+// Identity is an authenticated caller.
+//
+// Identity는 인증된 호출자입니다.
+type Identity struct {
+	Principal string   // 토큰 파일의 주체 또는 JWT의 sub
+	Roles     []string // 토큰 파일의 역할 또는 JWT의 roles 클레임
+	Method    Method
+}
+
+// Options configures the authenticator. At least one of TokensFile and
+// JWTSecretFile must be set.
+//
+// Options는 인증 설정입니다. TokensFile과 JWTSecretFile 중 하나 이상이 필요합니다.
+type Options struct {
+	TokensFile    string          // 정적 토큰 파일 (Secret 볼륨)
+	JWTSecretFile string          // JWT HMAC 키 파일 (Secret 볼륨)
+	JWTIssuer     string          // 비어있으면 iss를 검사하지 않음
+	JWTAudience   string          // 비어있으면 aud를 검사하지 않음
+	Roles         map[string]Role // DefaultRoles에 추가 (같은 이름이면 교체)
+
+	// TaskRepository는 Task에 기록된 Repository를 조회합니다 (nil이면 요청의 repository만 검사).
+	// ScaleDown은 요청 대신 기록된 Repository로, ScaleUp은 요청과 기록된 Repository 모두로 권한을 검사합니다.
+	TaskRepository TaskRepositoryFunc
+}
+
+// TaskRepositoryFunc returns the repository recorded for a task. found is false
+// when the task does not exist.
+//
+// TaskRepositoryFunc는 Task의 부모 오브젝트에 기록된 Repository를 반환합니다 (없으면 found=false).
+type TaskRepositoryFunc func(ctx context.Context, taskID string) (repository string, found bool, err error)
+
+// Authenticator authenticates and authorizes OttoscalerService calls.
+//
+// Authenticator는 OttoscalerService 호출을 인증하고 권한을 검사하는 인터셉터를 제공합니다.
+// 다른 서비스(LogStreamingService 등)의 호출은 검사하지 않고 통과시킵니다.
+type Authenticator struct {
+	opts  Options
+	roles map[string]Role
+
+	mu     sync.RWMutex
+	tokens tokenSet     // TokensFile이 없으면 nil
+	jwt    *jwtVerifier // JWTSecretFile이 없으면 nil
+	stamp  string       // 마지막으로 읽은 파일들의 변경 시간과 크기
+}
+
+// identityKey는 컨텍스트에 Identity를 저장하는 키입니다
+type identityKey struct{}
+
+// New creates an authenticator and loads its token and key files.
+//
+// New는 역할 설정을 검사하고 토큰 파일과 JWT 키 파일을 읽어 Authenticator를 생성합니다.
+func New(opts Options) (*Authenticator, error) {
+	if opts.TokensFile == "" && opts.JWTSecretFile == "" {
+		return nil, fmt.Errorf("auth: a tokens file or a JWT secret file is required")
+	}
+
+	roles := DefaultRoles()
+	for name, role := range opts.Roles {
+		roles[name] = role
+	}
+	if err := validateRoles(roles); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+
+	a := &Authenticator{opts: opts, roles: roles}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Run reloads the token and key files when they change.
+//
+// Run은 interval마다 토큰 파일과 JWT 키 파일의 변경을 확인하고 다시 읽습니다.
+// 새 파일을 읽지 못하면 이전 값을 유지합니다. ctx가 취소되면 끝납니다.
+func (a *Authenticator) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var failedStamp string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		a.mu.RLock()
+		current := a.stamp
+		a.mu.RUnlock()
+
+		stamp, err := a.fileStamp()
+		if err != nil || stamp == current {
+			continue
+		}
+		if err := a.reload(); err != nil {
+			if stamp != failedStamp {
+				log.Printf("⚠️ 인증 파일 다시 읽기 실패, 이전 설정을 계속 사용합니다: %v", err)
+				failedStamp = stamp
+			}
+			continue
+		}
+		failedStamp = ""
+		log.Printf("🔑 인증 토큰/키 파일 갱신")
+	}
+}
+
+// FromContext returns the caller authenticated by the interceptors.
+//
+// FromContext는 인터셉터가 인증한 호출자를 반환합니다.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// UnaryInterceptor authenticates and authorizes unary OttoscalerService calls.
+//
+// UnaryInterceptor는 OttoscalerService의 단일 요청 호출을 인증하고 권한을 검사합니다.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, protected := protectedMethod(info.FullMethod)
+		if !protected {
+			return handler(ctx, req)
+		}
+
+		identity, err := a.authenticate(ctx, method)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.authorize(ctx, identity, method, req); err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, identityKey{}, identity), req)
+	}
+}
+
+// StreamInterceptor authenticates and authorizes streaming OttoscalerService calls.
+//
+// StreamInterceptor는 OttoscalerService의 스트리밍 호출을 인증하고 권한을 검사합니다.
+// Repository 권한은 요청 메시지를 받을 때 검사합니다.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method, protected := protectedMethod(info.FullMethod)
+		if !protected {
+			return handler(srv, stream)
+		}
+
+		ctx := stream.Context()
+		identity, err := a.authenticate(ctx, method)
+		if err != nil {
+			return err
+		}
+		if err := a.authorize(ctx, identity, method, nil); err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(ctx, identityKey{}, identity),
+			authorize:    func(req interface{}) error { return a.authorize(ctx, identity, method, req) },
+		})
+	}
+}
+
+// authorizedStream은 받은 요청 메시지마다 Repository 권한을 검사하는 스트림입니다
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	authorize func(req interface{}) error
+}
+
+// Context는 호출자 정보가 담긴 컨텍스트를 반환합니다
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg는 요청 메시지를 받고 권한을 검사합니다
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorize(m)
+}
+
+// authenticate는 메타데이터의 Bearer 토큰으로 호출자를 인증합니다
+func (a *Authenticator) authenticate(ctx context.Context, method string) (Identity, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return Identity{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	a.mu.RLock()
+	tokens, verifier := a.tokens, a.jwt
+	a.mu.RUnlock()
+
+	if identity, ok := tokens.lookup(token); ok {
+		return identity, nil
+	}
+	if verifier != nil && strings.Count(token, ".") == 2 {
+		identity, err := verifier.verify(token, time.Now())
+		if err != nil {
+			log.Printf("🚫 %s 인증 실패: %v", method, err)
+			return Identity{}, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		}
+		return identity, nil
+	}
+
+	log.Printf("🚫 %s 인증 실패: 알 수 없는 토큰", method)
+	return Identity{}, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+// authorize는 호출자의 역할이 메서드와 (repository 필드가 있는 요청이면) Repository를 허용하는지 확인합니다.
+// req가 nil이면 메서드만 검사합니다. Repository 제한이 있는 역할은 빈 Repository를 허용하지 않습니다.
+func (a *Authenticator) authorize(ctx context.Context, identity Identity, method string, req interface{}) error {
+	repositories, scoped, err := a.repositories(ctx, method, req)
+	if err != nil {
+		return err
+	}
+
+	methodAllowed := false
+	for _, name := range identity.Roles {
+		role, exists := a.roles[name]
+		if !exists || !role.allowsMethod(method) {
+			continue
+		}
+		methodAllowed = true
+		if !scoped || role.allowsRepositories(repositories) {
+			return nil
+		}
+	}
+
+	if !methodAllowed {
+		log.Printf("🚫 %s 권한 없음: %s (역할: %s)", method, identity.Principal, strings.Join(identity.Roles, ","))
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", identity.Principal, method)
+	}
+	repository := strings.Join(repositories, ", ")
+	if repository == "" {
+		repository = "(none)"
+	}
+	log.Printf("🚫 %s 권한 없음: %s, repository %s", method, identity.Principal, repository)
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s for repository %s",
+		identity.Principal, method, repository)
+}
+
+// repositories는 요청에 대해 권한을 검사할 Repository 목록을 반환합니다.
+// repository 필드가 없는 요청이면 scoped=false입니다.
+//   - ScaleDown: 요청의 repository 대신 Task에 기록된 Repository (Task가 없으면 빈 값)
+//   - ScaleUp: 요청의 repository와, 이미 있는 Task이면 기록된 Repository
+//   - 그 외: 요청의 repository
+func (a *Authenticator) repositories(ctx context.Context, method string, req interface{}) ([]string, bool, error) {
+	withRepository, ok := req.(interface{ GetRepository() string })
+	if !ok {
+		return nil, false, nil
+	}
+
+	withTask, hasTask := req.(interface{ GetTaskId() string })
+	lookup := a.opts.TaskRepository
+	if lookup == nil || !hasTask || (method != "ScaleUp" && method != "ScaleDown") {
+		return []string{withRepository.GetRepository()}, true, nil
+	}
+
+	recorded, found, err := lookup(ctx, withTask.GetTaskId())
+	if err != nil {
+		log.Printf("❌ %s 권한 확인 실패: task %s의 Repository 조회: %v", method, withTask.GetTaskId(), err)
+		return nil, true, status.Errorf(codes.Unavailable, "failed to look up repository of task %s", withTask.GetTaskId())
+	}
+
+	if method == "ScaleDown" {
+		return []string{recorded}, true, nil
+	}
+	repositories := []string{withRepository.GetRepository()}
+	if found {
+		repositories = append(repositories, recorded)
+	}
+	return repositories, true, nil
+}
+
+// reload는 토큰 파일과 JWT 키 파일을 읽어 교체합니다
+func (a *Authenticator) reload() error {
+	stamp, err := a.fileStamp()
+	if err != nil {
+		return err
+	}
+
+	var tokens tokenSet
+	if a.opts.TokensFile != "" {
+		if tokens, err = loadTokens(a.opts.TokensFile); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	var verifier *jwtVerifier
+	if a.opts.JWTSecretFile != "" {
+		secret, err := loadSecretKey(a.opts.JWTSecretFile)
+		if err != nil {
+			return fmt.Errorf("auth: JWT secret: %w", err)
+		}
+		verifier = &jwtVerifier{secret: secret, issuer: a.opts.JWTIssuer, audience: a.opts.JWTAudience}
+	}
+
+	a.mu.Lock()
+	a.tokens = tokens
+	a.jwt = verifier
+	a.stamp = stamp
+	a.mu.Unlock()
+	return nil
+}
+
+// fileStamp는 토큰 파일과 JWT 키 파일의 변경 시간과 크기로 현재 상태를 나타냅니다
+func (a *Authenticator) fileStamp() (string, error) {
+	var parts []string
+	for _, file := range []string{a.opts.TokensFile, a.opts.JWTSecretFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", fmt.Errorf("auth: %w", err)
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d:%d", file, info.ModTime().UnixNano(), info.Size()))
+	}
+	return strings.Join(parts, "|"), nil
+}
+
+// bearerToken은 메타데이터의 "authorization: Bearer <토큰>"에서 토큰을 꺼냅니다
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", fmt.Errorf("missing authorization metadata")
+	}
+
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("authorization metadata must be \"Bearer <token>\"")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// protectedMethod는 전체 메서드 이름에서 RPC 이름을 꺼내고, 검사 대상 서비스인지 반환합니다
+func protectedMethod(fullMethod string) (string, bool) {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return method, found && service == protectedService
+}
@@ -0,0 +1,385 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const testJWTSecret = "0123456789abcdef0123456789abcdef"
+
+// newTestAuthenticator는 테스트용 토큰 파일과 JWT 키로 Authenticator를 생성합니다
+func newTestAuthenticator(t *testing.T, lookup TaskRepositoryFunc) *Authenticator {
+	t.Helper()
+
+	dir := t.TempDir()
+	tokensFile := filepath.Join(dir, "tokens")
+	tokens := "# test tokens\n" +
+		"admin-token admin admin\n" +
+		"handler-token otto-handler otto-handler\n" +
+		"read-token viewer readonly\n" +
+		"team-a-token team-a team-a\n"
+	if err := os.WriteFile(tokensFile, []byte(tokens), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	secretFile := filepath.Join(dir, "jwt-secret")
+	if err := os.WriteFile(secretFile, []byte(testJWTSecret+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(Options{
+		TokensFile:    tokensFile,
+		JWTSecretFile: secretFile,
+		JWTAudience:   "ottoscaler",
+		Roles: map[string]Role{
+			"team-a": {
+				Methods:      []string{"ScaleUp", "ScaleDown", "ExecutePipeline", "GetWorkerStatus"},
+				Repositories: []string{"github.com/team-a/*"},
+			},
+		},
+		TaskRepository: lookup,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// recordedRepositories는 Task별로 기록된 Repository를 돌려주는 조회 함수입니다
+func recordedRepositories(tasks map[string]string) TaskRepositoryFunc {
+	return func(ctx context.Context, taskID string) (string, bool, error) {
+		repository, found := tasks[taskID]
+		return repository, found, nil
+	}
+}
+
+// withToken은 Bearer 토큰 메타데이터가 담긴 수신 컨텍스트를 반환합니다
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// callUnary는 UnaryInterceptor로 메서드를 호출하고 핸들러가 받은 호출자와 에러를 반환합니다
+func callUnary(a *Authenticator, ctx context.Context, method string, req interface{}) (Identity, error) {
+	var identity Identity
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + protectedService + "/" + method}
+	_, err := a.UnaryInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, _ = FromContext(ctx)
+		return nil, nil
+	})
+	return identity, err
+}
+
+// signJWT는 테스트용 HS256 JWT를 생성합니다
+func signJWT(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(testJWTSecret))
+	mac.Write([]byte(body))
+	return body + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestUnaryInterceptorAuthentication(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	req := &pb.WorkerStatusRequest{}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"no metadata", context.Background(), codes.Unauthenticated},
+		{"wrong scheme", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic read-token")), codes.Unauthenticated},
+		{"empty token", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer ")), codes.Unauthenticated},
+		{"unknown token", withToken("nope"), codes.Unauthenticated},
+		{"static token", withToken("read-token"), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := callUnary(a, tt.ctx, "GetWorkerStatus", req)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestUnaryInterceptorSetsIdentity(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+
+	identity, err := callUnary(a, withToken("handler-token"), "GetWorkerStatus", &pb.WorkerStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Principal != "otto-handler" || identity.Method != MethodToken {
+		t.Fatalf("identity = %+v", identity)
+	}
+}
+
+func TestUnaryInterceptorSkipsOtherServices(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+
+	called := false
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.LogStreamingService_ServiceDesc.ServiceName + "/RegisterWorker"}
+	_, err := a.UnaryInterceptor()(context.Background(), &pb.WorkerRegistration{}, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+	if err != nil || !called {
+		t.Fatalf("unprotected service was not passed through: called=%v err=%v", called, err)
+	}
+}
+
+func TestJWTAuthentication(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+		code   codes.Code
+	}{
+		{"valid", map[string]interface{}{"sub": "ci", "aud": "ottoscaler", "exp": now.Add(time.Hour).Unix(), "roles": []string{"readonly"}}, codes.OK},
+		{"audience list", map[string]interface{}{"sub": "ci", "aud": []string{"other", "ottoscaler"}, "exp": now.Add(time.Hour).Unix(), "roles": []string{"readonly"}}, codes.OK},
+		{"expired", map[string]interface{}{"sub": "ci", "aud": "ottoscaler", "exp": now.Add(-time.Hour).Unix(), "roles": []string{"readonly"}}, codes.Unauthenticated},
+		{"no exp", map[string]interface{}{"sub": "ci", "aud": "ottoscaler", "roles": []string{"readonly"}}, codes.Unauthenticated},
+		{"wrong audience", map[string]interface{}{"sub": "ci", "aud": "other", "exp": now.Add(time.Hour).Unix(), "roles": []string{"readonly"}}, codes.Unauthenticated},
+		{"no role for method", map[string]interface{}{"sub": "ci", "aud": "ottoscaler", "exp": now.Add(time.Hour).Unix()}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := callUnary(a, withToken(signJWT(t, tt.claims)), "GetWorkerStatus", &pb.WorkerStatusRequest{})
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", got, tt.code, err)
+			}
+		})
+	}
+
+	t.Run("bad signature", func(t *testing.T) {
+		token := signJWT(t, map[string]interface{}{"sub": "ci", "aud": "ottoscaler", "exp": now.Add(time.Hour).Unix()})
+		_, err := callUnary(a, withToken(token+"x"), "GetWorkerStatus", &pb.WorkerStatusRequest{})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("err = %v, want Unauthenticated", err)
+		}
+	})
+}
+
+func TestAuthorizeMethods(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	scale := &pb.ScaleRequest{TaskId: "t1", Repository: "github.com/team-a/app"}
+
+	tests := []struct {
+		token  string
+		method string
+		code   codes.Code
+	}{
+		{"admin-token", "ScaleUp", codes.OK},
+		{"handler-token", "ScaleUp", codes.OK},
+		{"handler-token", "ScaleDown", codes.OK},
+		{"read-token", "ScaleUp", codes.PermissionDenied},
+		{"read-token", "ScaleDown", codes.PermissionDenied},
+		{"team-a-token", "ScaleUp", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token+"/"+tt.method, func(t *testing.T) {
+			_, err := callUnary(a, withToken(tt.token), tt.method, scale)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRepositories(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+
+	tests := []struct {
+		name       string
+		token      string
+		repository string
+		code       codes.Code
+	}{
+		{"allowed https url", "team-a-token", "https://github.com/team-a/app.git", codes.OK},
+		{"allowed scp url", "team-a-token", "git@github.com:Team-A/app.git", codes.OK},
+		{"other owner", "team-a-token", "https://github.com/team-b/app", codes.PermissionDenied},
+		{"empty repository", "team-a-token", "", codes.PermissionDenied},
+		{"blank repository", "team-a-token", "  ", codes.PermissionDenied},
+		{"unscoped role with empty repository", "handler-token", "", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, method := range []string{"ScaleUp", "ExecutePipeline"} {
+				var req interface{} = &pb.ScaleRequest{TaskId: "t1", Repository: tt.repository}
+				if method == "ExecutePipeline" {
+					req = &pb.PipelineRequest{PipelineId: "p1", Repository: tt.repository}
+				}
+				_, err := callUnary(a, withToken(tt.token), method, req)
+				if got := status.Code(err); got != tt.code {
+					t.Fatalf("%s: code = %v, want %v (err: %v)", method, got, tt.code, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizeScaleDownUsesRecordedRepository(t *testing.T) {
+	a := newTestAuthenticator(t, recordedRepositories(map[string]string{
+		"a1": "https://github.com/team-a/app.git",
+		"b1": "https://github.com/team-b/app.git",
+	}))
+
+	tests := []struct {
+		name  string
+		token string
+		req   *pb.ScaleRequest
+		code  codes.Code
+	}{
+		{"own task", "team-a-token", &pb.ScaleRequest{TaskId: "a1"}, codes.OK},
+		{"other task with allowed repository in request", "team-a-token",
+			&pb.ScaleRequest{TaskId: "b1", Repository: "github.com/team-a/app"}, codes.PermissionDenied},
+		{"unknown task", "team-a-token", &pb.ScaleRequest{TaskId: "missing", Repository: "github.com/team-a/app"}, codes.PermissionDenied},
+		{"unscoped role", "handler-token", &pb.ScaleRequest{TaskId: "b1"}, codes.OK},
+		{"unscoped role unknown task", "handler-token", &pb.ScaleRequest{TaskId: "missing"}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := callUnary(a, withToken(tt.token), "ScaleDown", tt.req)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeScaleUpChecksExistingTask(t *testing.T) {
+	a := newTestAuthenticator(t, recordedRepositories(map[string]string{
+		"b1": "https://github.com/team-b/app.git",
+	}))
+
+	_, err := callUnary(a, withToken("team-a-token"), "ScaleUp", &pb.ScaleRequest{TaskId: "b1", Repository: "github.com/team-a/app"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("rerunning another repository's task: err = %v, want PermissionDenied", err)
+	}
+
+	_, err = callUnary(a, withToken("team-a-token"), "ScaleUp", &pb.ScaleRequest{TaskId: "new", Repository: "github.com/team-a/app"})
+	if err != nil {
+		t.Fatalf("new task: err = %v", err)
+	}
+}
+
+func TestAuthorizeLookupFailure(t *testing.T) {
+	a := newTestAuthenticator(t, func(ctx context.Context, taskID string) (string, bool, error) {
+		return "", false, errors.New("api server unavailable")
+	})
+
+	_, err := callUnary(a, withToken("team-a-token"), "ScaleDown", &pb.ScaleRequest{TaskId: "a1"})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("err = %v, want Unavailable", err)
+	}
+}
+
+// fakeServerStream은 미리 정한 요청 하나를 돌려주는 서버 스트림입니다
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+
+	tests := []struct {
+		name       string
+		token      string
+		repository string
+		code       codes.Code
+	}{
+		{"allowed repository", "team-a-token", "github.com/team-a/app", codes.OK},
+		{"other repository", "team-a-token", "github.com/team-b/app", codes.PermissionDenied},
+		{"empty repository", "team-a-token", "", codes.PermissionDenied},
+		{"method not allowed", "read-token", "github.com/team-a/app", codes.PermissionDenied},
+		{"unauthenticated", "nope", "github.com/team-a/app", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &fakeServerStream{
+				ctx: withToken(tt.token),
+				req: &pb.PipelineRequest{PipelineId: "p1", Repository: tt.repository},
+			}
+			info := &grpc.StreamServerInfo{FullMethod: "/" + protectedService + "/ExecutePipeline", IsServerStream: true}
+			err := a.StreamInterceptor()(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+				if _, ok := FromContext(stream.Context()); !ok {
+					t.Error("identity missing from stream context")
+				}
+				return stream.RecvMsg(&pb.PipelineRequest{})
+			})
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestNewRejectsInvalidRoles(t *testing.T) {
+	dir := t.TempDir()
+	tokensFile := filepath.Join(dir, "tokens")
+	if err := os.WriteFile(tokensFile, []byte("t p admin\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]Role{
+		"unknown method":  {Methods: []string{"Nope"}},
+		"invalid pattern": {Methods: []string{"ScaleUp"}, Repositories: []string{"github.com/[a"}},
+	}
+	for name, role := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := New(Options{TokensFile: tokensFile, Roles: map[string]Role{"bad": role}}); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestNormalizeRepository(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/Team-5-CodeCat/ottoscaler.git": "github.com/team-5-codecat/ottoscaler",
+		"git@github.com:Team-5-CodeCat/ottoscaler.git":     "github.com/team-5-codecat/ottoscaler",
+		"ssh://git@github.com/a/b":                         "github.com/a/b",
+		"https://user@gitlab.com/a/b/":                     "gitlab.com/a/b",
+		"github.com/a/b":                                   "github.com/a/b",
+		"":                                                 "",
+	}
+	for input, want := range tests {
+		if got := NormalizeRepository(input); got != want {
+			t.Errorf("NormalizeRepository(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// bootstrapTokenPrefix는 부트스트랩 토큰 형식 버전입니다
+const bootstrapTokenPrefix = "otb1"
+
+// DefaultBootstrapTTL은 부트스트랩 토큰의 기본 유효 기간입니다
+const DefaultBootstrapTTL = time.Hour
+
+// BootstrapClaims identifies the worker a bootstrap token was minted for.
+//
+// BootstrapClaims는 부트스트랩 토큰이 발급된 Worker입니다.
+// Pod와 Job 중 하나가 설정됩니다 (Job 백엔드는 Pod 이름을 미리 알 수 없어 Job 이름에 묶음).
+type BootstrapClaims struct {
+	Pod       string    `json:"pod,omitempty"`
+	Job       string    `json:"job,omitempty"`
+	TaskID    string    `json:"task,omitempty"`
+	Namespace string    `json:"ns"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// BootstrapSigner mints and verifies per-worker bootstrap tokens.
+//
+// BootstrapSigner는 Worker Pod에 주입하는 짧은 수명의 HMAC 서명 토큰을 발급하고 검증합니다.
+// 토큰 형식: otb1.<base64url(JSON 클레임)>.<base64url(HMAC-SHA256)>
+type BootstrapSigner struct {
+	key []byte
+	ttl time.Duration
+}
+
+// NewBootstrapSigner creates a signer. An empty key generates a random one,
+// which invalidates outstanding tokens when the process restarts.
+//
+// NewBootstrapSigner는 부트스트랩 토큰 서명기를 생성합니다.
+// key가 비어있으면 임의의 키를 생성합니다 (재시작하면 실행 중인 Worker의 토큰이 무효가 됨).
+// ttl이 0 이하이면 DefaultBootstrapTTL을 사용합니다.
+func NewBootstrapSigner(key []byte, ttl time.Duration) (*BootstrapSigner, error) {
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("auth: failed to generate bootstrap key: %w", err)
+		}
+	} else if len(key) < 32 {
+		return nil, fmt.Errorf("auth: bootstrap key must be at least 32 bytes")
+	}
+	if ttl <= 0 {
+		ttl = DefaultBootstrapTTL
+	}
+	return &BootstrapSigner{key: key, ttl: ttl}, nil
+}
+
+// LoadBootstrapSigner creates a signer whose key is read from file
+// (a random key when file is empty).
+//
+// LoadBootstrapSigner는 키 파일(Secret 볼륨)을 읽어 서명기를 생성합니다.
+// file이 비어있으면 임의의 키를 사용합니다.
+func LoadBootstrapSigner(file string, ttl time.Duration) (*BootstrapSigner, error) {
+	if file == "" {
+		return NewBootstrapSigner(nil, ttl)
+	}
+	key, err := loadSecretKey(file)
+	if err != nil {
+		return nil, fmt.Errorf("auth: bootstrap key: %w", err)
+	}
+	return NewBootstrapSigner(key, ttl)
+}
+
+// Mint returns a token for claims that expires after the signer's TTL.
+//
+// Mint는 claims에 대한 토큰을 발급합니다. 만료 시간은 now + TTL로 설정됩니다.
+func (s *BootstrapSigner) Mint(claims BootstrapClaims, now time.Time) (string, error) {
+	if (claims.Pod == "") == (claims.Job == "") {
+		return "", errors.New("auth: bootstrap token needs exactly one of pod and job")
+	}
+	claims.ExpiresAt = now.Add(s.ttl).UTC().Truncate(time.Second)
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("auth: failed to encode bootstrap claims: %w", err)
+	}
+	body := bootstrapTokenPrefix + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(s.sign(body)), nil
+}
+
+// Verify checks the token signature and expiry and returns its claims.
+//
+// Verify는 토큰의 서명과 만료 시간을 검사하고 클레임을 반환합니다.
+func (s *BootstrapSigner) Verify(token string, now time.Time) (BootstrapClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != bootstrapTokenPrefix {
+		return BootstrapClaims{}, errors.New("malformed bootstrap token")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.sign(parts[0]+"."+parts[1])) {
+		return BootstrapClaims{}, errors.New("invalid bootstrap token signature")
+	}
+
+	var claims BootstrapClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return BootstrapClaims{}, fmt.Errorf("malformed bootstrap token claims: %w", err)
+	}
+	if now.After(claims.ExpiresAt) {
+		return BootstrapClaims{}, fmt.Errorf("bootstrap token expired at %s", claims.ExpiresAt.Format(time.RFC3339))
+	}
+	return claims, nil
+}
+
+// sign은 토큰 본문의 HMAC-SHA256 서명을 계산합니다
+func (s *BootstrapSigner) sign(body string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"strings"
+	"time"
+)
+
+// jwtLeeway는 exp/nbf 검사에서 허용하는 시계 오차입니다
+const jwtLeeway = 30 * time.Second
+
+// jwtVerifier는 HMAC(HS256/HS384/HS512)으로 서명된 JWT를 검증합니다
+type jwtVerifier struct {
+	secret   []byte
+	issuer   string // 비어있으면 검사하지 않음
+	audience string // 비어있으면 검사하지 않음
+}
+
+// jwtClaims는 검사하는 JWT 클레임입니다
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"` // 문자열 또는 문자열 배열
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+	Roles     []string        `json:"roles"`
+}
+
+// loadSecretKey는 HMAC 키 파일을 읽습니다 (앞뒤 공백과 줄바꿈 제거, 32바이트 이상)
+func loadSecretKey(file string) ([]byte, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	secret := bytes.TrimSpace(data)
+	if len(secret) < 32 {
+		return nil, fmt.Errorf("key in %s must be at least 32 bytes", file)
+	}
+	return secret, nil
+}
+
+// verify는 JWT 서명과 클레임을 검사하고 호출자 정보를 반환합니다
+func (v *jwtVerifier) verify(token string, now time.Time) (Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Identity{}, errors.New("malformed JWT")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Identity{}, fmt.Errorf("malformed JWT header: %w", err)
+	}
+
+	var newHash func() hash.Hash
+	switch header.Algorithm {
+	case "HS256":
+		newHash = sha256.New
+	case "HS384":
+		newHash = sha512.New384
+	case "HS512":
+		newHash = sha512.New
+	default:
+		return Identity{}, fmt.Errorf("unsupported JWT algorithm %q", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Identity{}, errors.New("malformed JWT signature")
+	}
+	mac := hmac.New(newHash, v.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return Identity{}, errors.New("invalid JWT signature")
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Identity{}, fmt.Errorf("malformed JWT claims: %w", err)
+	}
+	if claims.ExpiresAt == nil {
+		return Identity{}, errors.New("JWT has no exp claim")
+	}
+	if now.After(unixTime(*claims.ExpiresAt).Add(jwtLeeway)) {
+		return Identity{}, errors.New("JWT has expired")
+	}
+	if claims.NotBefore != nil && now.Add(jwtLeeway).Before(unixTime(*claims.NotBefore)) {
+		return Identity{}, errors.New("JWT is not valid yet")
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return Identity{}, fmt.Errorf("unexpected JWT issuer %q", claims.Issuer)
+	}
+	if v.audience != "" && !hasAudience(claims.Audience, v.audience) {
+		return Identity{}, errors.New("JWT audience does not include this server")
+	}
+	if claims.Subject == "" {
+		return Identity{}, errors.New("JWT has no sub claim")
+	}
+
+	return Identity{Principal: claims.Subject, Roles: claims.Roles, Method: MethodJWT}, nil
+}
+
+// decodeSegment는 base64url로 인코딩된 JWT JSON 조각을 읽습니다
+func decodeSegment(segment string, value interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, value)
+}
+
+// hasAudience는 aud 클레임(문자열 또는 배열)에 audience가 있는지 확인합니다
+func hasAudience(raw json.RawMessage, audience string) bool {
+	var single string
+	if json.Unmarshal(raw, &single) == nil {
+		return single == audience
+	}
+	var list []string
+	if json.Unmarshal(raw, &list) == nil {
+		for _, value := range list {
+			if value == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unixTime은 NumericDate(초, 소수 허용)를 시간으로 변환합니다
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// Role grants a set of OttoscalerService methods, optionally limited to repositories.
+//
+// Role은 호출할 수 있는 OttoscalerService 메서드와 Repository 범위입니다.
+// Methods는 RPC 이름(예: "ScaleUp") 또는 전체를 뜻하는 "*"이며,
+// Repositories는 path.Match 패턴 (예: "github.com/Team-5-CodeCat/*", 비어있으면 모든 Repository)입니다.
+// Repository 제한은 repository 필드가 있는 요청(ScaleUp, ScaleDown, ExecutePipeline)에만 적용되며,
+// 제한이 있으면 repository가 비어있는 요청은 거부합니다. ScaleDown은 Task에 기록된 Repository로 검사합니다.
+type Role struct {
+	Methods      []string
+	Repositories []string
+}
+
+// DefaultRoles returns the built-in roles.
+//
+// DefaultRoles는 기본 역할을 반환합니다.
+//   - admin: 모든 메서드
+//   - otto-handler: 스케일링, Pipeline 실행, 상태와 로그 조회
+//   - readonly: 상태와 로그 조회
+func DefaultRoles() map[string]Role {
+	read := []string{"GetWorkerStatus", "GetWorkerLogs", "TailLogs", "SearchLogs"}
+	return map[string]Role{
+		"admin":        {Methods: []string{"*"}},
+		"otto-handler": {Methods: append([]string{"ScaleUp", "ScaleDown", "ExecutePipeline"}, read...)},
+		"readonly":     {Methods: read},
+	}
+}
+
+// protectedService는 인증과 권한 검사를 적용하는 서비스입니다
+var protectedService = pb.OttoscalerService_ServiceDesc.ServiceName
+
+// serviceMethods는 OttoscalerService의 RPC 이름 목록입니다
+func serviceMethods() map[string]bool {
+	methods := make(map[string]bool)
+	for _, method := range pb.OttoscalerService_ServiceDesc.Methods {
+		methods[method.MethodName] = true
+	}
+	for _, stream := range pb.OttoscalerService_ServiceDesc.Streams {
+		methods[stream.StreamName] = true
+	}
+	return methods
+}
+
+// validateRoles는 역할의 메서드 이름과 Repository 패턴을 검사합니다
+func validateRoles(roles map[string]Role) error {
+	known := serviceMethods()
+	for name, role := range roles {
+		for _, method := range role.Methods {
+			if method != "*" && !known[method] {
+				return fmt.Errorf("role %s: unknown method %q", name, method)
+			}
+		}
+		for _, pattern := range role.Repositories {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("role %s: invalid repository pattern %q: %w", name, pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// allowsMethod는 역할이 메서드를 허용하는지 확인합니다
+func (r Role) allowsMethod(method string) bool {
+	for _, allowed := range r.Methods {
+		if allowed == "*" || allowed == method {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsRepositories는 역할이 모든 Repository를 허용하는지 확인합니다
+func (r Role) allowsRepositories(repositories []string) bool {
+	for _, repository := range repositories {
+		if !r.allowsRepository(repository) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsRepository는 역할이 Repository를 허용하는지 확인합니다.
+// 제한이 있는 역할은 빈 Repository를 허용하지 않습니다.
+func (r Role) allowsRepository(repository string) bool {
+	if len(r.Repositories) == 0 {
+		return true
+	}
+	normalized := NormalizeRepository(repository)
+	if normalized == "" {
+		return false
+	}
+	for _, pattern := range r.Repositories {
+		if pattern == "*" {
+			return true
+		}
+		if matched, _ := path.Match(strings.ToLower(pattern), normalized); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeRepository converts a repository URL to "host/owner/name".
+//
+// NormalizeRepository는 Repository URL을 "host/owner/name" 형태로 바꿉니다
+// (https://github.com/a/b.git, git@github.com:a/b.git → github.com/a/b).
+func NormalizeRepository(repository string) string {
+	repo := strings.TrimSpace(repository)
+	if i := strings.Index(repo, "://"); i >= 0 {
+		repo = repo[i+3:]
+	} else if at := strings.Index(repo, "@"); at >= 0 {
+		// scp 형식 (git@host:owner/name)
+		repo = strings.Replace(repo[at+1:], ":", "/", 1)
+	}
+	if at := strings.LastIndex(repo, "@"); at >= 0 && at < strings.Index(repo+"/", "/") {
+		repo = repo[at+1:] // 사용자 정보 제거
+	}
+	repo = strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+	return strings.ToLower(repo)
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// tokenSet은 정적 Bearer 토큰 목록입니다. 토큰 원문 대신 SHA-256 해시로 조회하여
+// 비교 시간이 토큰 내용에 따라 달라지지 않습니다.
+type tokenSet map[[sha256.Size]byte]Identity
+
+// loadTokens는 토큰 파일을 읽습니다.
+//
+// 파일 형식 (Secret 볼륨에 마운트, 빈 줄과 #으로 시작하는 줄은 무시):
+//
+//	<토큰> <주체> <역할>[,<역할>...]
+func loadTokens(file string) (tokenSet, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tokens file: %w", err)
+	}
+
+	tokens := make(tokenSet)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("tokens file line %d: expected \"<token> <principal> <roles>\"", lineNumber)
+		}
+		hash := sha256.Sum256([]byte(fields[0]))
+		if _, exists := tokens[hash]; exists {
+			return nil, fmt.Errorf("tokens file line %d: duplicate token", lineNumber)
+		}
+		tokens[hash] = Identity{
+			Principal: fields[1],
+			Roles:     splitRoles(fields[2]),
+			Method:    MethodToken,
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tokens file: %w", err)
+	}
+	return tokens, nil
+}
+
+// lookup은 토큰에 해당하는 호출자를 찾습니다
+func (t tokenSet) lookup(token string) (Identity, bool) {
+	identity, ok := t[sha256.Sum256([]byte(token))]
+	return identity, ok
+}
+
+// splitRoles는 쉼표로 구분된 역할 목록을 나눕니다
+func splitRoles(value string) []string {
+	var roles []string
+	for _, role := range strings.Split(value, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,13 +16,72 @@ type Config struct {
 	Kubernetes KubernetesConfig `yaml:"kubernetes"`
 	Worker     WorkerConfig     `yaml:"worker"`
 	Logging    LoggingConfig    `yaml:"logging"`
+	Health     HealthConfig     `yaml:"health"`
 }
 
 // GRPCConfig holds gRPC server configuration
 type GRPCConfig struct {
 	Port            int    `yaml:"port"`
 	OttoHandlerHost string `yaml:"otto_handler_host"`
-	MockMode        bool   `yaml:"mock_mode"` // Mock mode for development/testing
+	MockMode        bool   `yaml:"mock_mode"`  // Mock mode for development/testing
+	Reflection      bool   `yaml:"reflection"` // gRPC 서버 리플렉션 (grpcurl 등 디버깅용)
+
+	TLS            ServerTLSConfig `yaml:"tls"`              // gRPC 서버 TLS/mTLS
+	OttoHandlerTLS ClientTLSConfig `yaml:"otto_handler_tls"` // Otto-handler 연결 TLS/mTLS
+
+	Auth AuthConfig `yaml:"auth"` // OttoscalerService 호출자 인증/권한
+}
+
+// ServerTLSConfig holds TLS settings for the gRPC server
+//
+// 인증서 파일이 바뀌면 (cert-manager 갱신 등) 재시작 없이 다시 읽습니다.
+type ServerTLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	CAFile     string `yaml:"ca_file"`     // 클라이언트 인증서를 검증할 CA
+	ClientAuth string `yaml:"client_auth"` // none, request, require, verify_if_given, require_and_verify
+}
+
+// ClientTLSConfig holds TLS settings for the connection to otto-handler
+type ClientTLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CertFile   string `yaml:"cert_file"` // 클라이언트 인증서 (mTLS, 선택)
+	KeyFile    string `yaml:"key_file"`
+	CAFile     string `yaml:"ca_file"`     // 서버 인증서를 검증할 CA (비어있으면 시스템 CA)
+	ServerName string `yaml:"server_name"` // 서버 인증서에서 확인할 이름 (비어있으면 접속 주소의 호스트)
+}
+
+// AuthConfig holds caller authentication and authorization settings
+//
+// 토큰 파일과 JWT 키 파일은 Secret 볼륨에 마운트하며, 바뀌면 재시작 없이 다시 읽습니다.
+type AuthConfig struct {
+	Enabled    bool                  `yaml:"enabled"`
+	TokensFile string                `yaml:"tokens_file"` // "<토큰> <주체> <역할,...>" 줄 목록
+	JWT        JWTConfig             `yaml:"jwt"`
+	Roles      map[string]RoleConfig `yaml:"roles"` // 기본 역할(admin, otto-handler, readonly)에 추가/교체
+}
+
+// JWTConfig holds settings for HMAC-signed JWT bearer tokens
+type JWTConfig struct {
+	SecretFile string `yaml:"secret_file"` // HMAC 키 (32바이트 이상)
+	Issuer     string `yaml:"issuer"`      // 비어있으면 iss를 검사하지 않음
+	Audience   string `yaml:"audience"`    // 비어있으면 aud를 검사하지 않음
+}
+
+// RoleConfig lists the methods and repositories a role may use
+type RoleConfig struct {
+	Methods      []string `yaml:"methods"`      // RPC 이름 또는 "*"
+	Repositories []string `yaml:"repositories"` // Repository 패턴 (비어있으면 전체)
+}
+
+// HealthConfig holds health check settings
+//
+// gRPC 포트에는 grpc.health.v1 서비스가 항상 등록되며, Port가 0이 아니면
+// HTTP /healthz, /readyz 서버를 함께 실행합니다.
+type HealthConfig struct {
+	Port                 int `yaml:"port"`                   // HTTP 헬스 체크 포트 (0이면 비활성화)
+	CheckIntervalSeconds int `yaml:"check_interval_seconds"` // 준비 상태 검사 간격
 }
 
 // KubernetesConfig holds Kubernetes cluster configuration
@@ -35,12 +96,116 @@ type WorkerConfig struct {
 	CPULimit    string            `yaml:"cpu_limit"`
 	MemoryLimit string            `yaml:"memory_limit"`
 	Labels      map[string]string `yaml:"labels"`
+	PodTemplate PodTemplateConfig `yaml:"pod_template"`
+	Cache       CacheConfig       `yaml:"cache"`
+	Backend     string            `yaml:"backend"` // "pod" (기본값), "job" 또는 "local"
+	Job         JobConfig         `yaml:"job"`
+	LocalDir    string            `yaml:"local_dir"` // local 백엔드의 Worker 작업 디렉토리 (비어있으면 OS 임시 디렉토리)
+	Bootstrap   BootstrapConfig   `yaml:"bootstrap"`
+}
+
+// BootstrapConfig holds settings for per-worker bootstrap tokens
+//
+// Worker Pod마다 Pod 이름과 Task에 묶인 서명 토큰을 OTTOSCALER_BOOTSTRAP_TOKEN 환경 변수로 주입하고,
+// RegisterWorker에서 검증합니다.
+type BootstrapConfig struct {
+	Required   bool   `yaml:"required"`    // 토큰 없는 RegisterWorker와 session_id 없는 StreamLogs 거부
+	KeyFile    string `yaml:"key_file"`    // HMAC 키 파일 (비어있으면 시작 시 임의 생성)
+	TTLSeconds int    `yaml:"ttl_seconds"` // 토큰 유효 기간
+}
+
+// PodTemplateConfig references a base PodTemplate for Worker Pods
+//
+// File과 Name 중 하나만 지정할 수 있습니다.
+// 생성된 Worker 컨테이너, 라벨, 어노테이션이 이 템플릿 위에 strategic merge 됩니다.
+type PodTemplateConfig struct {
+	File string `yaml:"file"` // PodTemplate YAML 파일 경로
+	Name string `yaml:"name"` // 클러스터 내 PodTemplate 오브젝트 이름 (Worker 네임스페이스)
+}
+
+// CacheConfig holds per-repository dependency cache configuration
+//
+// Repository와 캐시 키별로 PVC를 생성하여 Worker 간에 의존성 다운로드를 재사용합니다.
+// Paths의 각 항목(subPath 이름 → 마운트 경로)이 하나의 PVC 안에 분리되어 마운트됩니다.
+type CacheConfig struct {
+	Enabled      bool              `yaml:"enabled"`
+	StorageClass string            `yaml:"storage_class"` // 비어있으면 클러스터 기본 StorageClass
+	Size         string            `yaml:"size"`          // PVC 크기 제한 (예: "5Gi")
+	AccessMode   string            `yaml:"access_mode"`   // ReadWriteMany(기본값) 또는 ReadWriteOnce
+	MaxVolumes   int               `yaml:"max_volumes"`   // 초과 시 가장 오래 사용되지 않은 PVC부터 삭제
+	Paths        map[string]string `yaml:"paths"`         // 예: {"gomod": "/go/pkg/mod", "npm": "~/.npm"}
+}
+
+// JobConfig holds batch/v1 Job settings used when the worker backend is "job"
+//
+// worker_count개의 Worker는 하나의 Indexed Job으로 생성됩니다.
+type JobConfig struct {
+	BackoffLimit            int32   `yaml:"backoff_limit"`              // 실패 시 재시도 횟수
+	ActiveDeadlineSeconds   int64   `yaml:"active_deadline_seconds"`    // 0이면 제한 없음
+	TTLSecondsAfterFinished int32   `yaml:"ttl_seconds_after_finished"` // 0이면 완료 후 Ottoscaler가 직접 삭제
+	FailJobExitCodes        []int32 `yaml:"fail_job_exit_codes"`        // 재시도 없이 Job을 실패시킬 종료 코드
 }
 
 // LoggingConfig holds logging configuration
 type LoggingConfig struct {
-	Level  string `yaml:"level"`
-	Format string `yaml:"format"`
+	Level      string `yaml:"level"`
+	Format     string `yaml:"format"`
+	Forwarding bool   `yaml:"forwarding"`  // 수집한 Worker 로그를 Otto-handler로 전달할지 여부
+	BufferSize int    `yaml:"buffer_size"` // Pod별 로그 전달 버퍼 크기 (엔트리 수)
+
+	// Otto-handler 배치 전송과 Worker 로그 제한 (RegisterWorker의 LoggingConfig로도 전달)
+	BatchSize       int `yaml:"batch_size"`        // 배치당 최대 엔트리 수 (1이면 배치 없이 전송)
+	BatchIntervalMs int `yaml:"batch_interval_ms"` // 배치가 가득 차지 않아도 전송하는 간격
+	RateLimit       int `yaml:"rate_limit"`        // Worker당 초당 최대 로그 수 (0이면 무제한)
+	MaxMessageSize  int `yaml:"max_message_size"`  // 메시지 최대 바이트 수 (0이면 무제한)
+	MaxInFlight     int `yaml:"max_in_flight"`     // Worker당 ACK 없이 Otto-handler로 보낼 수 있는 최대 엔트리 수
+
+	MultiplexStreams int `yaml:"multiplex_streams"` // 모든 Worker가 공유하는 Otto-handler 스트림 수 (0이면 Worker마다 스트림)
+
+	ANSI string `yaml:"ansi"` // 로그의 ANSI 색상 코드 처리: keep, strip, html
+
+	Archive   LogArchiveConfig   `yaml:"archive"`
+	Redaction LogRedactionConfig `yaml:"redaction"`
+	Spool     LogSpoolConfig     `yaml:"spool"`
+	Search    LogSearchConfig    `yaml:"search"`
+}
+
+// LogSearchConfig holds configuration for the local log search index
+type LogSearchConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	Dir            string `yaml:"dir"`             // 색인 디렉토리 (재시작 후에도 유지되는 볼륨 권장)
+	RetentionHours int    `yaml:"retention_hours"` // Worker 로그를 색인에 보관하는 시간
+}
+
+// LogSpoolConfig holds configuration for the on-disk forwarding queue
+type LogSpoolConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	Dir      string `yaml:"dir"`       // 스풀 디렉토리 (재시작 후에도 유지되는 볼륨 권장)
+	MaxBytes int    `yaml:"max_bytes"` // 작업별 최대 크기 (초과 시 Worker에 RETRY 응답)
+}
+
+// LogRedactionConfig holds configuration for masking secrets in worker logs
+type LogRedactionConfig struct {
+	Enabled  bool              `yaml:"enabled"`
+	Patterns map[string]string `yaml:"patterns"` // 이름 → 정규식 (기본 패턴에 추가, 같은 이름이면 교체, 빈 값이면 끔)
+}
+
+// LogArchiveConfig holds configuration for durable worker log storage
+type LogArchiveConfig struct {
+	Enabled bool          `yaml:"enabled"`
+	Backend string        `yaml:"backend"` // local 또는 s3
+	Dir     string        `yaml:"dir"`     // local 백엔드 저장 디렉토리
+	S3      S3StoreConfig `yaml:"s3"`
+}
+
+// S3StoreConfig holds connection settings for an S3-compatible object store (e.g. MinIO)
+type S3StoreConfig struct {
+	Endpoint  string `yaml:"endpoint"` // 예: http://minio:9000
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	Prefix    string `yaml:"prefix"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
 }
 
 // Load loads configuration from YAML file and environment variables
@@ -69,6 +234,30 @@ func LoadFromEnv() (*Config, error) {
 			Port:            getEnvInt("GRPC_PORT", 9090),
 			OttoHandlerHost: getEnv("OTTO_HANDLER_HOST", "otto-handler:8080"),
 			MockMode:        getEnvBool("GRPC_MOCK_MODE", true), // Default to mock mode for safety
+			Reflection:      getEnvBool("GRPC_REFLECTION_ENABLED", false),
+			TLS: ServerTLSConfig{
+				Enabled:    getEnvBool("GRPC_TLS_ENABLED", false),
+				CertFile:   getEnv("GRPC_TLS_CERT_FILE", ""),
+				KeyFile:    getEnv("GRPC_TLS_KEY_FILE", ""),
+				CAFile:     getEnv("GRPC_TLS_CA_FILE", ""),
+				ClientAuth: getEnv("GRPC_TLS_CLIENT_AUTH", "none"),
+			},
+			OttoHandlerTLS: ClientTLSConfig{
+				Enabled:    getEnvBool("OTTO_HANDLER_TLS_ENABLED", false),
+				CertFile:   getEnv("OTTO_HANDLER_TLS_CERT_FILE", ""),
+				KeyFile:    getEnv("OTTO_HANDLER_TLS_KEY_FILE", ""),
+				CAFile:     getEnv("OTTO_HANDLER_TLS_CA_FILE", ""),
+				ServerName: getEnv("OTTO_HANDLER_TLS_SERVER_NAME", ""),
+			},
+			Auth: AuthConfig{
+				Enabled:    getEnvBool("AUTH_ENABLED", false),
+				TokensFile: getEnv("AUTH_TOKENS_FILE", ""),
+				JWT: JWTConfig{
+					SecretFile: getEnv("AUTH_JWT_SECRET_FILE", ""),
+					Issuer:     getEnv("AUTH_JWT_ISSUER", ""),
+					Audience:   getEnv("AUTH_JWT_AUDIENCE", ""),
+				},
+			},
 		},
 		Kubernetes: KubernetesConfig{
 			Namespace:      getEnv("NAMESPACE", "default"),
@@ -81,10 +270,75 @@ func LoadFromEnv() (*Config, error) {
 			Labels: map[string]string{
 				"managed-by": "ottoscaler",
 			},
+			PodTemplate: PodTemplateConfig{
+				File: getEnv("WORKER_POD_TEMPLATE_FILE", ""),
+				Name: getEnv("WORKER_POD_TEMPLATE_NAME", ""),
+			},
+			Cache: CacheConfig{
+				Enabled:      getEnvBool("WORKER_CACHE_ENABLED", false),
+				StorageClass: getEnv("WORKER_CACHE_STORAGE_CLASS", ""),
+				Size:         getEnv("WORKER_CACHE_SIZE", "5Gi"),
+				AccessMode:   getEnv("WORKER_CACHE_ACCESS_MODE", "ReadWriteMany"),
+				MaxVolumes:   getEnvInt("WORKER_CACHE_MAX_VOLUMES", 20),
+				Paths:        parsePathMap(getEnv("WORKER_CACHE_PATHS", "gomod=/go/pkg/mod,npm=~/.npm")),
+			},
+			Backend:  getEnv("WORKER_BACKEND", "pod"),
+			LocalDir: getEnv("WORKER_LOCAL_DIR", ""),
+			Bootstrap: BootstrapConfig{
+				Required:   getEnvBool("WORKER_BOOTSTRAP_REQUIRED", true),
+				KeyFile:    getEnv("WORKER_BOOTSTRAP_KEY_FILE", ""),
+				TTLSeconds: getEnvInt("WORKER_BOOTSTRAP_TOKEN_TTL_SECONDS", 3600),
+			},
+			Job: JobConfig{
+				BackoffLimit:            int32(getEnvInt("WORKER_JOB_BACKOFF_LIMIT", 3)),
+				ActiveDeadlineSeconds:   int64(getEnvInt("WORKER_JOB_ACTIVE_DEADLINE_SECONDS", 0)),
+				TTLSecondsAfterFinished: int32(getEnvInt("WORKER_JOB_TTL_SECONDS_AFTER_FINISHED", 300)),
+				FailJobExitCodes:        parseInt32List(getEnv("WORKER_JOB_FAIL_EXIT_CODES", "")),
+			},
 		},
 		Logging: LoggingConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Format: getEnv("LOG_FORMAT", "text"),
+			Level:      getEnv("LOG_LEVEL", "info"),
+			Format:     getEnv("LOG_FORMAT", "text"),
+			Forwarding: getEnvBool("LOG_FORWARDING_ENABLED", true),
+			BufferSize: getEnvInt("LOG_BUFFER_SIZE", 1000),
+
+			BatchSize:        getEnvInt("LOG_BATCH_SIZE", 50),
+			BatchIntervalMs:  getEnvInt("LOG_BATCH_INTERVAL_MS", 1000),
+			RateLimit:        getEnvInt("LOG_RATE_LIMIT", 100),
+			MaxMessageSize:   getEnvInt("LOG_MAX_MESSAGE_SIZE", 1024),
+			MaxInFlight:      getEnvInt("LOG_MAX_IN_FLIGHT", 1000),
+			MultiplexStreams: getEnvInt("LOG_MULTIPLEX_STREAMS", 0),
+			ANSI:             getEnv("LOG_ANSI_MODE", "keep"),
+			Archive: LogArchiveConfig{
+				Enabled: getEnvBool("LOG_ARCHIVE_ENABLED", false),
+				Backend: getEnv("LOG_ARCHIVE_BACKEND", "local"),
+				Dir:     getEnv("LOG_ARCHIVE_DIR", "/var/lib/ottoscaler/logs"),
+				S3: S3StoreConfig{
+					Endpoint:  getEnv("LOG_ARCHIVE_S3_ENDPOINT", ""),
+					Region:    getEnv("LOG_ARCHIVE_S3_REGION", "us-east-1"),
+					Bucket:    getEnv("LOG_ARCHIVE_S3_BUCKET", ""),
+					Prefix:    getEnv("LOG_ARCHIVE_S3_PREFIX", ""),
+					AccessKey: getEnv("LOG_ARCHIVE_S3_ACCESS_KEY", ""),
+					SecretKey: getEnv("LOG_ARCHIVE_S3_SECRET_KEY", ""),
+				},
+			},
+			Redaction: LogRedactionConfig{
+				Enabled: getEnvBool("LOG_REDACTION_ENABLED", true),
+			},
+			Spool: LogSpoolConfig{
+				Enabled:  getEnvBool("LOG_SPOOL_ENABLED", false),
+				Dir:      getEnv("LOG_SPOOL_DIR", "/var/lib/ottoscaler/spool"),
+				MaxBytes: getEnvInt("LOG_SPOOL_MAX_BYTES", 64<<20),
+			},
+			Search: LogSearchConfig{
+				Enabled:        getEnvBool("LOG_SEARCH_ENABLED", false),
+				Dir:            getEnv("LOG_SEARCH_DIR", "/var/lib/ottoscaler/search"),
+				RetentionHours: getEnvInt("LOG_SEARCH_RETENTION_HOURS", 72),
+			},
+		},
+		Health: HealthConfig{
+			Port:                 getEnvInt("HEALTH_PORT", 8080),
+			CheckIntervalSeconds: getEnvInt("HEALTH_CHECK_INTERVAL_SECONDS", 5),
 		},
 	}
 
@@ -124,6 +378,54 @@ func overrideWithEnv(config *Config) {
 	if mockMode := os.Getenv("GRPC_MOCK_MODE"); mockMode != "" {
 		config.GRPC.MockMode = parseBool(mockMode)
 	}
+	if reflection := os.Getenv("GRPC_REFLECTION_ENABLED"); reflection != "" {
+		config.GRPC.Reflection = parseBool(reflection)
+	}
+	if tlsEnabled := os.Getenv("GRPC_TLS_ENABLED"); tlsEnabled != "" {
+		config.GRPC.TLS.Enabled = parseBool(tlsEnabled)
+	}
+	if certFile := os.Getenv("GRPC_TLS_CERT_FILE"); certFile != "" {
+		config.GRPC.TLS.CertFile = certFile
+	}
+	if keyFile := os.Getenv("GRPC_TLS_KEY_FILE"); keyFile != "" {
+		config.GRPC.TLS.KeyFile = keyFile
+	}
+	if caFile := os.Getenv("GRPC_TLS_CA_FILE"); caFile != "" {
+		config.GRPC.TLS.CAFile = caFile
+	}
+	if clientAuth := os.Getenv("GRPC_TLS_CLIENT_AUTH"); clientAuth != "" {
+		config.GRPC.TLS.ClientAuth = clientAuth
+	}
+	if tlsEnabled := os.Getenv("OTTO_HANDLER_TLS_ENABLED"); tlsEnabled != "" {
+		config.GRPC.OttoHandlerTLS.Enabled = parseBool(tlsEnabled)
+	}
+	if certFile := os.Getenv("OTTO_HANDLER_TLS_CERT_FILE"); certFile != "" {
+		config.GRPC.OttoHandlerTLS.CertFile = certFile
+	}
+	if keyFile := os.Getenv("OTTO_HANDLER_TLS_KEY_FILE"); keyFile != "" {
+		config.GRPC.OttoHandlerTLS.KeyFile = keyFile
+	}
+	if caFile := os.Getenv("OTTO_HANDLER_TLS_CA_FILE"); caFile != "" {
+		config.GRPC.OttoHandlerTLS.CAFile = caFile
+	}
+	if serverName := os.Getenv("OTTO_HANDLER_TLS_SERVER_NAME"); serverName != "" {
+		config.GRPC.OttoHandlerTLS.ServerName = serverName
+	}
+	if authEnabled := os.Getenv("AUTH_ENABLED"); authEnabled != "" {
+		config.GRPC.Auth.Enabled = parseBool(authEnabled)
+	}
+	if tokensFile := os.Getenv("AUTH_TOKENS_FILE"); tokensFile != "" {
+		config.GRPC.Auth.TokensFile = tokensFile
+	}
+	if secretFile := os.Getenv("AUTH_JWT_SECRET_FILE"); secretFile != "" {
+		config.GRPC.Auth.JWT.SecretFile = secretFile
+	}
+	if issuer := os.Getenv("AUTH_JWT_ISSUER"); issuer != "" {
+		config.GRPC.Auth.JWT.Issuer = issuer
+	}
+	if audience := os.Getenv("AUTH_JWT_AUDIENCE"); audience != "" {
+		config.GRPC.Auth.JWT.Audience = audience
+	}
 
 	// Kubernetes overrides
 	if namespace := os.Getenv("NAMESPACE"); namespace != "" {
@@ -143,6 +445,67 @@ func overrideWithEnv(config *Config) {
 	if memoryLimit := os.Getenv("WORKER_MEMORY_LIMIT"); memoryLimit != "" {
 		config.Worker.MemoryLimit = memoryLimit
 	}
+	if templateFile := os.Getenv("WORKER_POD_TEMPLATE_FILE"); templateFile != "" {
+		config.Worker.PodTemplate.File = templateFile
+	}
+	if templateName := os.Getenv("WORKER_POD_TEMPLATE_NAME"); templateName != "" {
+		config.Worker.PodTemplate.Name = templateName
+	}
+	if cacheEnabled := os.Getenv("WORKER_CACHE_ENABLED"); cacheEnabled != "" {
+		config.Worker.Cache.Enabled = parseBool(cacheEnabled)
+	}
+	if storageClass := os.Getenv("WORKER_CACHE_STORAGE_CLASS"); storageClass != "" {
+		config.Worker.Cache.StorageClass = storageClass
+	}
+	if cacheSize := os.Getenv("WORKER_CACHE_SIZE"); cacheSize != "" {
+		config.Worker.Cache.Size = cacheSize
+	}
+	if accessMode := os.Getenv("WORKER_CACHE_ACCESS_MODE"); accessMode != "" {
+		config.Worker.Cache.AccessMode = accessMode
+	}
+	if maxVolumes := os.Getenv("WORKER_CACHE_MAX_VOLUMES"); maxVolumes != "" {
+		if maxVolumesInt, err := strconv.Atoi(maxVolumes); err == nil {
+			config.Worker.Cache.MaxVolumes = maxVolumesInt
+		}
+	}
+	if cachePaths := os.Getenv("WORKER_CACHE_PATHS"); cachePaths != "" {
+		config.Worker.Cache.Paths = parsePathMap(cachePaths)
+	}
+	if backend := os.Getenv("WORKER_BACKEND"); backend != "" {
+		config.Worker.Backend = backend
+	}
+	if localDir := os.Getenv("WORKER_LOCAL_DIR"); localDir != "" {
+		config.Worker.LocalDir = localDir
+	}
+	if backoffLimit := os.Getenv("WORKER_JOB_BACKOFF_LIMIT"); backoffLimit != "" {
+		if backoffLimitInt, err := strconv.Atoi(backoffLimit); err == nil {
+			config.Worker.Job.BackoffLimit = int32(backoffLimitInt)
+		}
+	}
+	if deadline := os.Getenv("WORKER_JOB_ACTIVE_DEADLINE_SECONDS"); deadline != "" {
+		if deadlineInt, err := strconv.Atoi(deadline); err == nil {
+			config.Worker.Job.ActiveDeadlineSeconds = int64(deadlineInt)
+		}
+	}
+	if ttl := os.Getenv("WORKER_JOB_TTL_SECONDS_AFTER_FINISHED"); ttl != "" {
+		if ttlInt, err := strconv.Atoi(ttl); err == nil {
+			config.Worker.Job.TTLSecondsAfterFinished = int32(ttlInt)
+		}
+	}
+	if exitCodes := os.Getenv("WORKER_JOB_FAIL_EXIT_CODES"); exitCodes != "" {
+		config.Worker.Job.FailJobExitCodes = parseInt32List(exitCodes)
+	}
+	if required := os.Getenv("WORKER_BOOTSTRAP_REQUIRED"); required != "" {
+		config.Worker.Bootstrap.Required = parseBool(required)
+	}
+	if keyFile := os.Getenv("WORKER_BOOTSTRAP_KEY_FILE"); keyFile != "" {
+		config.Worker.Bootstrap.KeyFile = keyFile
+	}
+	if ttl := os.Getenv("WORKER_BOOTSTRAP_TOKEN_TTL_SECONDS"); ttl != "" {
+		if ttlInt, err := strconv.Atoi(ttl); err == nil {
+			config.Worker.Bootstrap.TTLSeconds = ttlInt
+		}
+	}
 
 	// Logging overrides
 	if level := os.Getenv("LOG_LEVEL"); level != "" {
@@ -151,6 +514,111 @@ func overrideWithEnv(config *Config) {
 	if format := os.Getenv("LOG_FORMAT"); format != "" {
 		config.Logging.Format = format
 	}
+	if forwarding := os.Getenv("LOG_FORWARDING_ENABLED"); forwarding != "" {
+		config.Logging.Forwarding = parseBool(forwarding)
+	}
+	if bufferSize := os.Getenv("LOG_BUFFER_SIZE"); bufferSize != "" {
+		if bufferSizeInt, err := strconv.Atoi(bufferSize); err == nil {
+			config.Logging.BufferSize = bufferSizeInt
+		}
+	}
+	if batchSize := os.Getenv("LOG_BATCH_SIZE"); batchSize != "" {
+		if batchSizeInt, err := strconv.Atoi(batchSize); err == nil {
+			config.Logging.BatchSize = batchSizeInt
+		}
+	}
+	if batchInterval := os.Getenv("LOG_BATCH_INTERVAL_MS"); batchInterval != "" {
+		if batchIntervalInt, err := strconv.Atoi(batchInterval); err == nil {
+			config.Logging.BatchIntervalMs = batchIntervalInt
+		}
+	}
+	if rateLimit := os.Getenv("LOG_RATE_LIMIT"); rateLimit != "" {
+		if rateLimitInt, err := strconv.Atoi(rateLimit); err == nil {
+			config.Logging.RateLimit = rateLimitInt
+		}
+	}
+	if maxMessageSize := os.Getenv("LOG_MAX_MESSAGE_SIZE"); maxMessageSize != "" {
+		if maxMessageSizeInt, err := strconv.Atoi(maxMessageSize); err == nil {
+			config.Logging.MaxMessageSize = maxMessageSizeInt
+		}
+	}
+	if maxInFlight := os.Getenv("LOG_MAX_IN_FLIGHT"); maxInFlight != "" {
+		if maxInFlightInt, err := strconv.Atoi(maxInFlight); err == nil {
+			config.Logging.MaxInFlight = maxInFlightInt
+		}
+	}
+	if multiplexStreams := os.Getenv("LOG_MULTIPLEX_STREAMS"); multiplexStreams != "" {
+		if multiplexStreamsInt, err := strconv.Atoi(multiplexStreams); err == nil {
+			config.Logging.MultiplexStreams = multiplexStreamsInt
+		}
+	}
+	if ansiMode := os.Getenv("LOG_ANSI_MODE"); ansiMode != "" {
+		config.Logging.ANSI = ansiMode
+	}
+	if archiveEnabled := os.Getenv("LOG_ARCHIVE_ENABLED"); archiveEnabled != "" {
+		config.Logging.Archive.Enabled = parseBool(archiveEnabled)
+	}
+	if archiveBackend := os.Getenv("LOG_ARCHIVE_BACKEND"); archiveBackend != "" {
+		config.Logging.Archive.Backend = archiveBackend
+	}
+	if archiveDir := os.Getenv("LOG_ARCHIVE_DIR"); archiveDir != "" {
+		config.Logging.Archive.Dir = archiveDir
+	}
+	if endpoint := os.Getenv("LOG_ARCHIVE_S3_ENDPOINT"); endpoint != "" {
+		config.Logging.Archive.S3.Endpoint = endpoint
+	}
+	if region := os.Getenv("LOG_ARCHIVE_S3_REGION"); region != "" {
+		config.Logging.Archive.S3.Region = region
+	}
+	if bucket := os.Getenv("LOG_ARCHIVE_S3_BUCKET"); bucket != "" {
+		config.Logging.Archive.S3.Bucket = bucket
+	}
+	if prefix := os.Getenv("LOG_ARCHIVE_S3_PREFIX"); prefix != "" {
+		config.Logging.Archive.S3.Prefix = prefix
+	}
+	if accessKey := os.Getenv("LOG_ARCHIVE_S3_ACCESS_KEY"); accessKey != "" {
+		config.Logging.Archive.S3.AccessKey = accessKey
+	}
+	if secretKey := os.Getenv("LOG_ARCHIVE_S3_SECRET_KEY"); secretKey != "" {
+		config.Logging.Archive.S3.SecretKey = secretKey
+	}
+	if redaction := os.Getenv("LOG_REDACTION_ENABLED"); redaction != "" {
+		config.Logging.Redaction.Enabled = parseBool(redaction)
+	}
+	if spoolEnabled := os.Getenv("LOG_SPOOL_ENABLED"); spoolEnabled != "" {
+		config.Logging.Spool.Enabled = parseBool(spoolEnabled)
+	}
+	if spoolDir := os.Getenv("LOG_SPOOL_DIR"); spoolDir != "" {
+		config.Logging.Spool.Dir = spoolDir
+	}
+	if spoolMaxBytes := os.Getenv("LOG_SPOOL_MAX_BYTES"); spoolMaxBytes != "" {
+		if spoolMaxBytesInt, err := strconv.Atoi(spoolMaxBytes); err == nil {
+			config.Logging.Spool.MaxBytes = spoolMaxBytesInt
+		}
+	}
+	if searchEnabled := os.Getenv("LOG_SEARCH_ENABLED"); searchEnabled != "" {
+		config.Logging.Search.Enabled = parseBool(searchEnabled)
+	}
+	if searchDir := os.Getenv("LOG_SEARCH_DIR"); searchDir != "" {
+		config.Logging.Search.Dir = searchDir
+	}
+	if retention := os.Getenv("LOG_SEARCH_RETENTION_HOURS"); retention != "" {
+		if retentionInt, err := strconv.Atoi(retention); err == nil {
+			config.Logging.Search.RetentionHours = retentionInt
+		}
+	}
+
+	// Health overrides
+	if healthPort := os.Getenv("HEALTH_PORT"); healthPort != "" {
+		if healthPortInt, err := strconv.Atoi(healthPort); err == nil {
+			config.Health.Port = healthPortInt
+		}
+	}
+	if interval := os.Getenv("HEALTH_CHECK_INTERVAL_SECONDS"); interval != "" {
+		if intervalInt, err := strconv.Atoi(interval); err == nil {
+			config.Health.CheckIntervalSeconds = intervalInt
+		}
+	}
 }
 
 // validate validates the configuration
@@ -159,6 +627,31 @@ func validate(config *Config) error {
 		return fmt.Errorf("invalid gRPC port: %d", config.GRPC.Port)
 	}
 
+	if serverTLS := config.GRPC.TLS; serverTLS.Enabled {
+		if serverTLS.CertFile == "" || serverTLS.KeyFile == "" {
+			return fmt.Errorf("grpc tls: cert file and key file are required")
+		}
+		switch serverTLS.ClientAuth {
+		case "", "none", "request", "require":
+		case "verify_if_given", "require_and_verify":
+			if serverTLS.CAFile == "" {
+				return fmt.Errorf("grpc tls: client auth %s requires a ca file", serverTLS.ClientAuth)
+			}
+		default:
+			return fmt.Errorf("grpc tls: unsupported client auth %q (expected none, request, require, verify_if_given or require_and_verify)", serverTLS.ClientAuth)
+		}
+	}
+	if clientTLS := config.GRPC.OttoHandlerTLS; clientTLS.Enabled {
+		if (clientTLS.CertFile == "") != (clientTLS.KeyFile == "") {
+			return fmt.Errorf("otto-handler tls: cert file and key file must be set together")
+		}
+	}
+	if authConfig := config.GRPC.Auth; authConfig.Enabled {
+		if authConfig.TokensFile == "" && authConfig.JWT.SecretFile == "" {
+			return fmt.Errorf("auth: a tokens file or a jwt secret file is required")
+		}
+	}
+
 	if config.Kubernetes.Namespace == "" {
 		return fmt.Errorf("kubernetes namespace cannot be empty")
 	}
@@ -167,6 +660,111 @@ func validate(config *Config) error {
 		return fmt.Errorf("worker image cannot be empty")
 	}
 
+	if config.Worker.PodTemplate.File != "" && config.Worker.PodTemplate.Name != "" {
+		return fmt.Errorf("worker pod template: file and name are mutually exclusive")
+	}
+
+	switch config.Worker.Backend {
+	case "", "pod", "job", "local":
+	default:
+		return fmt.Errorf("unsupported worker backend %q (expected pod, job or local)", config.Worker.Backend)
+	}
+
+	if config.Worker.Job.BackoffLimit < 0 || config.Worker.Job.ActiveDeadlineSeconds < 0 ||
+		config.Worker.Job.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("worker job: backoff limit, active deadline and ttl must not be negative")
+	}
+	for _, code := range config.Worker.Job.FailJobExitCodes {
+		if code == 0 {
+			return fmt.Errorf("worker job: exit code 0 cannot be used in fail_job_exit_codes")
+		}
+	}
+	if config.Worker.Bootstrap.TTLSeconds < 0 {
+		return fmt.Errorf("worker bootstrap: token ttl must not be negative")
+	}
+
+	if config.Logging.BufferSize < 0 {
+		return fmt.Errorf("logging: buffer size must not be negative")
+	}
+	if config.Logging.BatchSize < 0 || config.Logging.BatchIntervalMs < 0 ||
+		config.Logging.RateLimit < 0 || config.Logging.MaxMessageSize < 0 || config.Logging.MaxInFlight < 0 {
+		return fmt.Errorf("logging: batch size, batch interval, rate limit, max message size and max in-flight must not be negative")
+	}
+	if config.Logging.MultiplexStreams < 0 {
+		return fmt.Errorf("logging: multiplex streams must not be negative")
+	}
+
+	switch config.Logging.ANSI {
+	case "", "keep", "strip", "html":
+	default:
+		return fmt.Errorf("logging: unsupported ansi mode %q (expected keep, strip or html)", config.Logging.ANSI)
+	}
+
+	if archive := config.Logging.Archive; archive.Enabled {
+		switch archive.Backend {
+		case "", "local":
+			if archive.Dir == "" {
+				return fmt.Errorf("log archive: dir is required for the local backend")
+			}
+		case "s3":
+			if archive.S3.Endpoint == "" || archive.S3.Bucket == "" {
+				return fmt.Errorf("log archive: s3 endpoint and bucket are required")
+			}
+		default:
+			return fmt.Errorf("log archive: unsupported backend %q (expected local or s3)", archive.Backend)
+		}
+	}
+
+	if logSpool := config.Logging.Spool; logSpool.Enabled {
+		if logSpool.Dir == "" {
+			return fmt.Errorf("log spool: dir is required")
+		}
+		if logSpool.MaxBytes < 0 {
+			return fmt.Errorf("log spool: max bytes must not be negative")
+		}
+	}
+
+	if logSearch := config.Logging.Search; logSearch.Enabled {
+		if logSearch.Dir == "" {
+			return fmt.Errorf("log search: dir is required")
+		}
+		if logSearch.RetentionHours < 0 {
+			return fmt.Errorf("log search: retention hours must not be negative")
+		}
+	}
+
+	for name, pattern := range config.Logging.Redaction.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("log redaction: invalid pattern %s: %w", name, err)
+		}
+	}
+
+	if config.Worker.Cache.Enabled {
+		switch config.Worker.Cache.AccessMode {
+		case "", "ReadWriteOnce", "ReadWriteMany":
+		default:
+			return fmt.Errorf("worker cache: unsupported access mode %q", config.Worker.Cache.AccessMode)
+		}
+		if len(config.Worker.Cache.Paths) == 0 {
+			return fmt.Errorf("worker cache: at least one cache path is required")
+		}
+		for name, path := range config.Worker.Cache.Paths {
+			if name == "" || strings.Contains(name, "/") || path == "" {
+				return fmt.Errorf("worker cache: invalid path entry %q=%q", name, path)
+			}
+		}
+	}
+
+	if config.Health.Port < 0 || config.Health.Port > 65535 {
+		return fmt.Errorf("invalid health port: %d", config.Health.Port)
+	}
+	if config.Health.Port == config.GRPC.Port {
+		return fmt.Errorf("health port must differ from the gRPC port (%d)", config.GRPC.Port)
+	}
+	if config.Health.CheckIntervalSeconds < 0 {
+		return fmt.Errorf("health: check interval must not be negative")
+	}
+
 	return nil
 }
 
@@ -218,6 +816,30 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// parsePathMap parses "name=path,name=path" into a map
+func parsePathMap(value string) map[string]string {
+	paths := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		name, path, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			continue
+		}
+		paths[strings.TrimSpace(name)] = strings.TrimSpace(path)
+	}
+	return paths
+}
+
+// parseInt32List parses a comma-separated list of integers, skipping invalid entries
+func parseInt32List(value string) []int32 {
+	var values []int32
+	for _, entry := range strings.Split(value, ",") {
+		if intValue, err := strconv.Atoi(strings.TrimSpace(entry)); err == nil {
+			values = append(values, int32(intValue))
+		}
+	}
+	return values
+}
+
 // parseBool parses a string to boolean
 func parseBool(value string) bool {
 	switch value {
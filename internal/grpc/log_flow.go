@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// DefaultMaxInFlight는 Worker별로 ACK 없이 보낼 수 있는 최대 로그 엔트리 수의 기본값입니다
+	DefaultMaxInFlight = 1000
+
+	// inFlightWaitTimeout 동안 ACK가 오지 않아 여유가 생기지 않으면 전송을 포기합니다
+	inFlightWaitTimeout = 30 * time.Second
+	// closeDrainTimeout은 스트림을 닫기 전에 남은 ACK를 기다리는 최대 시간입니다
+	closeDrainTimeout = 5 * time.Second
+	// maxResendAttempts는 RETRY 응답으로 같은 로그를 다시 보내는 최대 횟수입니다
+	maxResendAttempts = 5
+)
+
+// inFlightItem은 ACK를 기다리는 로그 엔트리 또는 배치입니다
+type inFlightItem struct {
+	entry    *pb.WorkerLogEntry
+	batch    *pb.WorkerLogBatch
+	size     int // 포함된 엔트리 수
+	attempts int // 재전송 횟수
+}
+
+// sequence는 항목의 시퀀스를 반환합니다
+func (i *inFlightItem) sequence() int64 {
+	if i.batch != nil {
+		return i.batch.Sequence
+	}
+	return i.entry.Sequence
+}
+
+// logFlow controls the send path of one worker's log stream.
+//
+// logFlow는 Worker 로그 스트림 하나의 전송 흐름을 제어합니다.
+//   - 보낸 엔트리/배치를 시퀀스로 추적하고 ACK를 받으면 해제
+//   - ACK 없이 보낸 엔트리가 maxInFlight에 도달하면 전송을 대기 (백프레셔)
+//   - Otto-handler가 throttle_ms를 보내면 전송 간격을 토큰 버킷으로 제한
+//
+// 같은 Worker의 모든 송신자(엔트리, 배치 타이머, 재전송)가 하나의 logFlow를 공유합니다.
+type logFlow struct {
+	limiter     *rate.Limiter
+	maxInFlight int
+
+	mu       sync.Mutex
+	inFlight map[int64]*inFlightItem
+	entries  int           // in-flight 엔트리 수
+	released chan struct{} // 여유가 생기면 닫고 새로 만듦 (대기 중인 송신자 모두 깨움)
+	nextSeq  int64
+	throttle time.Duration
+	retried  int64
+	dropped  int64
+	failed   int64 // ACK 없이 전송에 실패해 포기한 엔트리 수 (takeFailed로 확인 후 초기화)
+}
+
+// newLogFlow는 제한 없는 토큰 버킷으로 logFlow를 생성합니다
+func newLogFlow(maxInFlight int) *logFlow {
+	if maxInFlight <= 0 {
+		maxInFlight = DefaultMaxInFlight
+	}
+	return &logFlow{
+		limiter:     rate.NewLimiter(rate.Inf, 1),
+		maxInFlight: maxInFlight,
+		inFlight:    make(map[int64]*inFlightItem),
+		released:    make(chan struct{}),
+	}
+}
+
+// sequenceFor는 엔트리의 시퀀스를 반환합니다. 스풀이 부여한 시퀀스가 없으면 새로 부여합니다.
+func (f *logFlow) sequenceFor(entry *pb.WorkerLogEntry) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if entry.Sequence == 0 {
+		f.nextSeq++
+		entry.Sequence = f.nextSeq
+	} else if entry.Sequence > f.nextSeq {
+		f.nextSeq = entry.Sequence
+	}
+	return entry.Sequence
+}
+
+// reserve는 in-flight 여유가 생길 때까지 기다린 뒤 item을 시퀀스로 등록합니다.
+// 아무것도 in-flight가 아니면 maxInFlight보다 큰 배치도 등록합니다.
+func (f *logFlow) reserve(ctx, streamCtx context.Context, seq int64, item *inFlightItem) error {
+	timeout := time.NewTimer(inFlightWaitTimeout)
+	defer timeout.Stop()
+
+	for {
+		f.mu.Lock()
+		if f.entries == 0 || f.entries+item.size <= f.maxInFlight {
+			f.inFlight[seq] = item
+			f.entries += item.size
+			f.mu.Unlock()
+			return nil
+		}
+		released, entries := f.released, f.entries
+		f.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-streamCtx.Done():
+			return fmt.Errorf("log stream closed while waiting for acknowledgements")
+		case <-timeout.C:
+			return fmt.Errorf("%d log entries are still waiting for acknowledgement from otto-handler", entries)
+		}
+	}
+}
+
+// acquire는 토큰 버킷에서 전송 한 번의 토큰을 기다립니다
+func (f *logFlow) acquire(ctx context.Context) error {
+	return f.limiter.Wait(ctx)
+}
+
+// release는 seq의 등록을 해제하고 반환합니다. seq가 0이면 가장 오래된 항목을 해제합니다
+// (시퀀스를 돌려주지 않는 Otto-handler 호환).
+func (f *logFlow) release(seq int64) *inFlightItem {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if seq == 0 {
+		seq = f.oldestLocked()
+	}
+	item, exists := f.inFlight[seq]
+	if !exists {
+		return nil
+	}
+	delete(f.inFlight, seq)
+	f.entries -= item.size
+	close(f.released)
+	f.released = make(chan struct{})
+	return item
+}
+
+// retry는 재전송할 항목을 반환합니다. 재전송 횟수를 넘었으면 등록을 해제하고 nil을 반환합니다.
+func (f *logFlow) retry(seq int64) (item *inFlightItem, exhausted bool) {
+	f.mu.Lock()
+	if seq == 0 {
+		seq = f.oldestLocked()
+	}
+	item, exists := f.inFlight[seq]
+	if !exists {
+		f.mu.Unlock()
+		return nil, false
+	}
+	item.attempts++
+	if item.attempts <= maxResendAttempts {
+		f.retried++
+		f.mu.Unlock()
+		return item, false
+	}
+	f.mu.Unlock()
+
+	f.fail(seq)
+	return nil, true
+}
+
+// drop은 seq를 포기한 항목으로 해제하고 폐기된 엔트리 수를 반환합니다
+func (f *logFlow) drop(seq int64) int {
+	item := f.release(seq)
+	if item == nil {
+		return 0
+	}
+	f.mu.Lock()
+	f.dropped += int64(item.size)
+	f.mu.Unlock()
+	return item.size
+}
+
+// fail은 전송에 실패해 포기한 항목을 해제합니다. 폐기로 집계되며 takeFailed에 포함됩니다.
+func (f *logFlow) fail(seq int64) {
+	item := f.release(seq)
+	if item == nil {
+		return
+	}
+	f.markFailed(item.size)
+}
+
+// markFailed는 in-flight로 등록되기 전에 전송에 실패한 엔트리 수를 집계합니다
+func (f *logFlow) markFailed(entries int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.dropped += int64(entries)
+	f.failed += int64(entries)
+}
+
+// takeFailed는 마지막 호출 이후 전송에 실패한 엔트리 수를 반환하고 초기화합니다
+func (f *logFlow) takeFailed() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	failed := f.failed
+	f.failed = 0
+	return failed
+}
+
+// unacked는 ACK를 기다리는 항목을 시퀀스 순서대로 반환합니다
+func (f *logFlow) unacked() []*inFlightItem {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	seqs := make([]int64, 0, len(f.inFlight))
+	for seq := range f.inFlight {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	items := make([]*inFlightItem, 0, len(seqs))
+	for _, seq := range seqs {
+		items = append(items, f.inFlight[seq])
+	}
+	return items
+}
+
+// oldestLocked는 가장 작은 in-flight 시퀀스를 반환합니다 (없으면 0)
+func (f *logFlow) oldestLocked() int64 {
+	var oldest int64
+	for seq := range f.inFlight {
+		if oldest == 0 || seq < oldest {
+			oldest = seq
+		}
+	}
+	return oldest
+}
+
+// setThrottle은 Otto-handler가 요청한 전송 간격을 토큰 버킷에 적용합니다.
+// 0이면 제한을 해제합니다. 변경되었으면 true를 반환합니다.
+func (f *logFlow) setThrottle(throttleMs int32) bool {
+	throttle := time.Duration(max(throttleMs, 0)) * time.Millisecond
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if throttle == f.throttle {
+		return false
+	}
+	f.throttle = throttle
+
+	if throttle > 0 {
+		f.limiter.SetLimit(rate.Every(throttle))
+	} else {
+		f.limiter.SetLimit(rate.Inf)
+	}
+	return true
+}
+
+// drain은 in-flight 항목이 모두 ACK되거나 ctx가 끝나거나 timeout이 지날 때까지 기다리고
+// 남은 in-flight 엔트리 수를 반환합니다
+func (f *logFlow) drain(ctx context.Context, timeout time.Duration) int {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		f.mu.Lock()
+		released, entries := f.released, f.entries
+		f.mu.Unlock()
+		if entries == 0 {
+			return 0
+		}
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return entries
+		case <-deadline.C:
+			return entries
+		}
+	}
+}
+
+// stats는 in-flight 엔트리 수와 재전송/폐기 통계를 반환합니다
+func (f *logFlow) stats() (inFlight int, retried, dropped int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.entries, f.retried, f.dropped
+}
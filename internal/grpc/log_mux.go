@@ -0,0 +1,462 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// maxUnroutedRefs는 worker_id 없는 응답을 라우팅하기 위해 기억하는 최대 전송 기록 수입니다
+	maxUnroutedRefs = 64 * 1024
+	// minReconnectDelay, maxReconnectDelay는 끊긴 공유 스트림을 다시 여는 간격의 범위입니다
+	minReconnectDelay = 1 * time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// logMux multiplexes the log traffic of many workers over a fixed pool of streams.
+//
+// logMux는 여러 Worker의 로그를 고정된 수의 장기 스트림으로 묶어 Otto-handler에 전송합니다.
+// Worker는 연결 시 가장 적은 Worker가 붙은 스트림에 고정되므로 Worker별 순서가 유지되고,
+// 각 스트림은 대기 중인 Worker를 라운드 로빈으로 돌며 한 번에 하나씩 전송합니다.
+// 스트림이 끊기면 Worker는 그대로 둔 채 스트림을 다시 열고, ACK를 받지 못한 항목을 다시 전송합니다.
+type logMux struct {
+	client  *OttoHandlerClient
+	streams []*muxStream
+}
+
+// muxStream은 풀의 스트림 하나와 여기에 붙은 Worker들의 전송 큐입니다
+type muxStream struct {
+	client *OttoHandlerClient
+	index  int
+	batch  bool // ForwardWorkerLogBatches 스트림 여부
+
+	mu           sync.Mutex
+	cond         *sync.Cond
+	conn         *muxConn // nil이면 다음 attach에서 새로 연결 (reconnecting이면 다시 여는 중)
+	reconnecting bool     // 끊긴 연결을 다시 여는 중 (Worker와 큐는 유지)
+	closed       bool     // 풀이 닫힘 (Disconnect)
+	workers      map[string]*LogStream
+	queues       map[string][]*muxSend
+	ring         []string // 전송할 엔트리가 있는 Worker (라운드 로빈 순서)
+	sent         []muxRef // 전송 순서 (worker_id 없는 응답의 라우팅용)
+}
+
+// muxConn은 muxStream의 gRPC 스트림 하나입니다 (끊기면 새로 만듦)
+type muxConn struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	entries pb.OttoHandlerLogService_ForwardWorkerLogsClient
+	batches pb.OttoHandlerLogService_ForwardWorkerLogBatchesClient
+}
+
+// muxSend는 전송 대기 중인 항목과 전송 결과를 받을 채널입니다
+type muxSend struct {
+	item *inFlightItem
+	done chan error
+}
+
+// muxRef는 전송한 항목의 Worker와 시퀀스입니다
+type muxRef struct {
+	workerID string
+	sequence int64
+}
+
+// newLogMux는 size개의 스트림으로 풀을 생성합니다. 스트림은 처음 사용할 때 연결됩니다.
+func newLogMux(client *OttoHandlerClient, size int, batch bool) *logMux {
+	mux := &logMux{client: client}
+	for i := 0; i < size; i++ {
+		stream := &muxStream{
+			client:  client,
+			index:   i,
+			batch:   batch,
+			workers: make(map[string]*LogStream),
+			queues:  make(map[string][]*muxSend),
+		}
+		stream.cond = sync.NewCond(&stream.mu)
+		mux.streams = append(mux.streams, stream)
+	}
+	return mux
+}
+
+// attach는 Worker 로그 스트림을 가장 한가한 풀 스트림에 고정합니다.
+// client는 풀 스트림이 연결되어 있지 않을 때 사용합니다 (호출자가 streamMu를 잡고 있으므로
+// OttoHandlerClient.mu를 다시 잡지 않도록 미리 조회해 전달).
+func (m *logMux) attach(stream *LogStream, client pb.OttoHandlerLogServiceClient) error {
+	target := m.streams[0]
+	load := -1
+	for _, candidate := range m.streams {
+		candidate.mu.Lock()
+		workers := len(candidate.workers)
+		candidate.mu.Unlock()
+		if load < 0 || workers < load {
+			target, load = candidate, workers
+		}
+	}
+
+	if err := target.attach(stream, client); err != nil {
+		return err
+	}
+	stream.mux = target
+	return nil
+}
+
+// close는 풀의 모든 스트림을 닫고 붙어 있던 Worker 스트림을 종료합니다
+func (m *logMux) close() {
+	for _, stream := range m.streams {
+		stream.mu.Lock()
+		stream.closed = true
+		if stream.conn != nil {
+			stream.conn.cancel()
+			stream.conn = nil
+		}
+		workers := stream.teardownLocked()
+		stream.mu.Unlock()
+
+		for _, worker := range workers {
+			worker.Cancel()
+		}
+	}
+}
+
+// attach는 Worker를 스트림에 등록하고, 연결되어 있지 않으면 새로 연결합니다
+func (s *muxStream) attach(stream *LogStream, client pb.OttoHandlerLogServiceClient) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("shared log stream #%d is closed", s.index)
+	}
+	if s.conn == nil && !s.reconnecting {
+		conn, err := s.open(client)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+		go s.sendLoop(conn)
+		go s.recvLoop(conn)
+		log.Printf("🔀 Otto-handler 공유 로그 스트림 #%d 연결", s.index)
+	}
+
+	s.workers[stream.WorkerID] = stream
+	return nil
+}
+
+// open은 새 gRPC 스트림을 엽니다
+func (s *muxStream) open(client pb.OttoHandlerLogServiceClient) (*muxConn, error) {
+	if client == nil {
+		return nil, fmt.Errorf("not connected to Otto-handler")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := &muxConn{ctx: ctx, cancel: cancel}
+
+	var err error
+	if s.batch {
+		conn.batches, err = client.ForwardWorkerLogBatches(ctx)
+	} else {
+		conn.entries, err = client.ForwardWorkerLogs(ctx)
+	}
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to create shared log stream: %w", err)
+	}
+	return conn, nil
+}
+
+// detach는 Worker를 스트림에서 분리합니다. 스트림 자체는 다른 Worker를 위해 유지됩니다.
+func (s *muxStream) detach(stream *LogStream) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.workers[stream.WorkerID] != stream {
+		return
+	}
+	delete(s.workers, stream.WorkerID)
+	for _, send := range s.queues[stream.WorkerID] {
+		send.done <- fmt.Errorf("log stream for worker %s closed", stream.WorkerID)
+	}
+	delete(s.queues, stream.WorkerID)
+	for i, workerID := range s.ring {
+		if workerID == stream.WorkerID {
+			s.ring = append(s.ring[:i], s.ring[i+1:]...)
+			break
+		}
+	}
+}
+
+// enqueue는 Worker 큐에 항목을 추가하고 실제로 전송될 때까지 기다립니다
+func (s *muxStream) enqueue(ctx context.Context, stream *LogStream, item *inFlightItem) error {
+	send := &muxSend{item: item, done: make(chan error, 1)}
+
+	s.mu.Lock()
+	if (s.conn == nil && !s.reconnecting) || s.workers[stream.WorkerID] != stream {
+		s.mu.Unlock()
+		return fmt.Errorf("shared log stream for worker %s is closed", stream.WorkerID)
+	}
+	if len(s.queues[stream.WorkerID]) == 0 {
+		s.ring = append(s.ring, stream.WorkerID)
+	}
+	s.queues[stream.WorkerID] = append(s.queues[stream.WorkerID], send)
+	s.cond.Signal()
+	s.mu.Unlock()
+
+	select {
+	case err := <-send.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendLoop는 대기 중인 Worker를 라운드 로빈으로 돌며 하나씩 전송합니다
+func (s *muxStream) sendLoop(conn *muxConn) {
+	for {
+		s.mu.Lock()
+		for s.conn == conn && len(s.ring) == 0 {
+			s.cond.Wait()
+		}
+		if s.conn != conn {
+			s.mu.Unlock()
+			return
+		}
+
+		workerID := s.ring[0]
+		s.ring = s.ring[1:]
+		queue := s.queues[workerID]
+		send := queue[0]
+		if len(queue) > 1 {
+			s.queues[workerID] = queue[1:]
+			s.ring = append(s.ring, workerID)
+		} else {
+			delete(s.queues, workerID)
+		}
+
+		s.sent = append(s.sent, muxRef{workerID: workerID, sequence: send.item.sequence()})
+		if len(s.sent) > maxUnroutedRefs {
+			s.sent = s.sent[len(s.sent)-maxUnroutedRefs:]
+		}
+		s.mu.Unlock()
+
+		err := conn.send(send.item)
+		if err != nil {
+			// 큐 앞에 되돌려 다시 연결한 스트림으로 전송 (Worker가 분리되었으면 실패 전달)
+			s.mu.Lock()
+			if s.workers[workerID] != nil {
+				s.queues[workerID] = append([]*muxSend{send}, s.queues[workerID]...)
+			} else {
+				send.done <- err
+			}
+			s.mu.Unlock()
+			s.fail(conn, err)
+			return
+		}
+		send.done <- nil
+	}
+}
+
+// recvLoop는 응답을 해당 Worker의 스트림으로 전달합니다
+func (s *muxStream) recvLoop(conn *muxConn) {
+	for {
+		resp, err := conn.recv()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("otto-handler closed the stream")
+			}
+			s.fail(conn, err)
+			return
+		}
+
+		if stream := s.route(resp); stream != nil {
+			s.client.processResponse(stream, resp)
+		}
+	}
+}
+
+// route는 응답 대상 Worker를 찾습니다. worker_id가 없으면 같은 시퀀스로 가장 먼저 보낸
+// 항목(시퀀스도 없으면 가장 오래된 항목)의 Worker로 보고 시퀀스를 채웁니다.
+func (s *muxStream) route(resp *pb.LogForwardResponse) *LogStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, ref := range s.sent {
+		matches := ref.sequence == resp.Sequence || resp.Sequence == 0
+		if resp.WorkerId != "" {
+			matches = matches && ref.workerID == resp.WorkerId
+		}
+		if !matches {
+			continue
+		}
+		s.sent = append(s.sent[:i], s.sent[i+1:]...)
+		if resp.WorkerId == "" {
+			resp.WorkerId = ref.workerID
+			resp.Sequence = ref.sequence
+		}
+		break
+	}
+
+	return s.workers[resp.WorkerId]
+}
+
+// fail은 끊긴 연결을 정리합니다. 붙어 있는 Worker가 있으면 Worker 스트림과 큐를 유지한 채
+// 스트림을 다시 열고 ACK를 받지 못한 항목을 다시 전송합니다 (reconnect).
+func (s *muxStream) fail(conn *muxConn, err error) {
+	s.mu.Lock()
+	if s.conn != conn {
+		s.mu.Unlock()
+		return
+	}
+	s.conn = nil
+	conn.cancel()
+	s.sent = nil
+	s.cond.Broadcast()
+
+	if s.closed || len(s.workers) == 0 {
+		s.mu.Unlock()
+		return
+	}
+	s.reconnecting = true
+	workers := len(s.workers)
+	s.mu.Unlock()
+
+	if err != nil && status.Code(err) != codes.Canceled {
+		log.Printf("❌ Otto-handler 공유 로그 스트림 #%d 오류, 다시 연결합니다 (Worker %d개): %v", s.index, workers, err)
+	}
+	go s.reconnect()
+}
+
+// reconnect는 끊긴 스트림을 백오프로 다시 열고, 붙어 있는 Worker의 ACK를 받지 못한 항목을
+// 시퀀스 순서대로 다시 전송합니다. Worker가 모두 분리되거나 풀이 닫히면 멈춥니다.
+func (s *muxStream) reconnect() {
+	delay := minReconnectDelay
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			time.Sleep(delay)
+			delay = min(delay*2, maxReconnectDelay)
+		}
+
+		s.mu.Lock()
+		if s.closed || len(s.workers) == 0 {
+			s.reconnecting = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+
+		s.client.mu.RLock()
+		client := s.client.client
+		s.client.mu.RUnlock()
+
+		conn, err := s.open(client)
+		if err != nil {
+			log.Printf("⚠️ Otto-handler 공유 로그 스트림 #%d 재연결 실패 (%d번째): %v", s.index, attempt+1, err)
+			continue
+		}
+
+		s.mu.Lock()
+		if s.closed || len(s.workers) == 0 {
+			s.reconnecting = false
+			s.mu.Unlock()
+			conn.cancel()
+			return
+		}
+		s.conn = conn
+		s.reconnecting = false
+		resent := s.requeueLocked()
+		workers := len(s.workers)
+		s.cond.Broadcast()
+		s.mu.Unlock()
+
+		go s.sendLoop(conn)
+		go s.recvLoop(conn)
+		log.Printf("🔀 Otto-handler 공유 로그 스트림 #%d 재연결 (Worker %d개, 재전송 %d개)", s.index, workers, resent)
+		return
+	}
+}
+
+// requeueLocked는 다시 연결한 스트림의 Worker별 큐를 ACK를 받지 못한 항목(이미 보냈거나
+// 큐에서 기다리던 항목)으로 시퀀스 순서대로 다시 만들고, 다시 보낼 항목 수를 반환합니다.
+// 큐에서 기다리던 송신자는 새 스트림으로 실제 전송될 때 결과를 받습니다.
+func (s *muxStream) requeueLocked() int {
+	waiting := make(map[*inFlightItem]*muxSend)
+	for _, queue := range s.queues {
+		for _, send := range queue {
+			waiting[send.item] = send
+		}
+	}
+
+	s.queues = make(map[string][]*muxSend)
+	s.ring = nil
+	resent := 0
+	for workerID, stream := range s.workers {
+		items := stream.flow.unacked()
+		if len(items) == 0 {
+			continue
+		}
+		queue := make([]*muxSend, 0, len(items))
+		for _, item := range items {
+			send, exists := waiting[item]
+			if exists {
+				delete(waiting, item)
+			} else {
+				send = &muxSend{item: item, done: make(chan error, 1)}
+			}
+			queue = append(queue, send)
+		}
+		s.queues[workerID] = queue
+		s.ring = append(s.ring, workerID)
+		resent += len(queue)
+	}
+
+	// in-flight에 없는 항목 (이미 ACK되었거나 포기됨)을 기다리던 송신자
+	for item, send := range waiting {
+		send.done <- fmt.Errorf("log item %d is no longer in flight", item.sequence())
+	}
+	return resent
+}
+
+// teardownLocked는 큐에서 기다리던 송신자에게 실패를 알리고 Worker 목록을 비운 뒤
+// 분리된 Worker 스트림을 반환합니다
+func (s *muxStream) teardownLocked() map[string]*LogStream {
+	for workerID, queue := range s.queues {
+		for _, send := range queue {
+			send.done <- fmt.Errorf("shared log stream for worker %s closed", workerID)
+		}
+	}
+	workers := s.workers
+	s.workers = make(map[string]*LogStream)
+	s.queues = make(map[string][]*muxSend)
+	s.ring = nil
+	s.sent = nil
+	s.reconnecting = false
+	s.cond.Broadcast()
+	return workers
+}
+
+// send는 항목을 스트림 종류에 맞게 전송합니다
+func (c *muxConn) send(item *inFlightItem) error {
+	switch {
+	case c.batches != nil && item.batch != nil:
+		return c.batches.Send(item.batch)
+	case c.entries != nil && item.entry != nil:
+		return c.entries.Send(item.entry)
+	default:
+		return fmt.Errorf("shared log stream does not accept this message type")
+	}
+}
+
+// recv는 다음 응답을 받습니다
+func (c *muxConn) recv() (*pb.LogForwardResponse, error) {
+	if c.batches != nil {
+		return c.batches.Recv()
+	}
+	return c.entries.Recv()
+}
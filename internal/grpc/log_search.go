@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/search"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// SearchLogs searches live and finished workers' logs through the local index.
+//
+// SearchLogs는 로그 수집 중에 만든 로컬 색인에서 Worker 로그를 검색합니다.
+// 작업, Pipeline/Stage, Worker, 시간 범위, 레벨로 대상을 한정하고, 부분 문자열이나
+// 정규식으로 찾은 라인을 앞뒤 문맥과 함께 페이지 단위로 반환합니다.
+func (s *Server) SearchLogs(ctx context.Context, req *pb.SearchLogsRequest) (*pb.SearchLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	logIndex := s.workerManager.LogIndex()
+	if logIndex == nil {
+		return nil, status.Error(codes.FailedPrecondition, "log search index is not enabled")
+	}
+
+	if req.ContextLines < 0 || req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "context_lines and limit must not be negative")
+	}
+	query := search.Query{
+		TaskID:     req.TaskId,
+		PipelineID: req.PipelineId,
+		StageID:    req.StageId,
+		WorkerIDs:  req.WorkerIds,
+		Pattern:    req.Query,
+		Regex:      req.Regex,
+		IgnoreCase: req.IgnoreCase,
+		MinLevel:   req.MinLevel,
+		Context:    int(req.ContextLines),
+		Limit:      int(req.Limit),
+		PageToken:  req.PageToken,
+	}
+
+	var err error
+	if req.Since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, req.Since); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+	}
+	if req.Until != "" {
+		if query.Until, err = time.Parse(time.RFC3339, req.Until); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+		}
+	}
+
+	log.Printf("🔎 SearchLogs 요청 수신: task_id=%s, pipeline_id=%s, stage_id=%s, workers=%d, query=%q, regex=%v",
+		req.TaskId, req.PipelineId, req.StageId, len(req.WorkerIds), req.Query, req.Regex)
+
+	result, err := logIndex.Search(ctx, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, search.ErrInvalidQuery):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case ctx.Err() != nil:
+			return nil, status.FromContextError(ctx.Err()).Err()
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to search logs: %v", err)
+		}
+	}
+
+	response := &pb.SearchLogsResponse{
+		NextPageToken:   result.NextPageToken,
+		ScannedSegments: int32(result.ScannedSegments),
+		SkippedSegments: int32(result.SkippedSegments),
+	}
+	for _, match := range result.Matches {
+		response.Matches = append(response.Matches, &pb.LogSearchMatch{
+			WorkerId:   match.Worker.WorkerID,
+			TaskId:     match.Worker.TaskID,
+			PipelineId: match.Worker.PipelineID,
+			StageId:    match.Worker.StageID,
+			Line:       searchLogLine(match.Entry),
+			Before:     searchLogLines(match.Before),
+			After:      searchLogLines(match.After),
+		})
+	}
+
+	log.Printf("✅ SearchLogs 완료: %d개 결과 (세그먼트 %d개 검사, %d개 건너뜀)",
+		len(response.Matches), result.ScannedSegments, result.SkippedSegments)
+	return response, nil
+}
+
+// searchLogLine은 색인된 엔트리를 응답 메시지로 변환합니다
+func searchLogLine(entry search.Entry) *pb.SearchLogLine {
+	return &pb.SearchLogLine{
+		Line:      entry.Line,
+		Timestamp: entry.Timestamp.Format(time.RFC3339Nano),
+		Level:     entry.Level,
+		Source:    entry.Source,
+		Message:   entry.Message,
+	}
+}
+
+// searchLogLines는 문맥 라인들을 응답 메시지로 변환합니다
+func searchLogLines(entries []search.Entry) []*pb.SearchLogLine {
+	lines := make([]*pb.SearchLogLine, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, searchLogLine(entry))
+	}
+	return lines
+}
@@ -6,16 +6,28 @@ package grpc
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
+	"unicode/utf8"
 
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/spool"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/tlsconfig"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/worker"
 	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
 )
 
@@ -38,6 +50,28 @@ type LogStreamingServer struct {
 	streamTimeout        time.Duration
 	maxRetries           int
 	retryDelay           time.Duration
+
+	// Worker에 전달하고 서버에서 적용하는 로깅 제한
+	loggingConfig *pb.LoggingConfig
+
+	// Secret 마스킹 설정
+	redaction worker.RedactionSettings
+
+	// 설정되면 로그를 디스크 스풀에 기록한 뒤 ACK (전달은 스풀이 담당)
+	spool *spool.Spool
+
+	// Worker 부트스트랩 토큰 검증 (nil이면 검증하지 않음)
+	workerVerifier WorkerVerifier
+	// true이면 RegisterWorker에 토큰, StreamLogs에 세션 ID가 필수
+	requireBootstrap bool
+}
+
+// WorkerVerifier verifies the bootstrap token a worker presents in RegisterWorker.
+//
+// WorkerVerifier는 RegisterWorker 요청의 부트스트랩 토큰과 Worker Pod를 검증합니다.
+// worker.Manager가 구현합니다.
+type WorkerVerifier interface {
+	VerifyWorker(ctx context.Context, token, workerID, taskID string) error
 }
 
 // StreamingSession represents an active log streaming session
@@ -52,6 +86,15 @@ type StreamingSession struct {
 	LogCount   int64
 	ErrorCount int64
 	IsActive   bool
+	Verified   bool // 부트스트랩 토큰으로 등록한 세션 (worker_id만으로는 찾을 수 없음)
+
+	// LoggingConfig 적용 결과
+	DroppedCount   int64 // 속도 제한으로 폐기된 로그 수
+	TruncatedCount int64 // max_message_size를 넘어 잘린 로그 수
+	BatchedCount   int64 // Otto-handler로 배치 전송된 로그 수
+
+	// Worker별 속도 제한 (rate_limit/초, 순간 최대 buffer_size개)
+	limiter *rate.Limiter
 
 	// Connection management
 	currentConnections int
@@ -63,14 +106,21 @@ type StreamingSession struct {
 	errorStream chan error
 	stopChan    chan struct{}
 	mu          sync.RWMutex
+
+	// Secret 마스킹 (첫 로그 전달 시 Worker Pod의 Secret으로 생성)
+	redactOnce sync.Once
+	redact     *logs.RedactStream
+	redactor   *logs.Redactor
 }
 
 // NewLogStreamingServer creates a new log streaming server instance.
 //
 // NewLogStreamingServer는 새로운 로그 스트리밍 서버 인스턴스를 생성합니다.
-func NewLogStreamingServer(k8sClient *k8s.Client, ottoHandlerAddress string, mockMode bool) *LogStreamingServer {
+// ottoHandlerTLS가 nil이 아니면 Otto-handler에 TLS로 연결합니다.
+func NewLogStreamingServer(k8sClient *k8s.Client, ottoHandlerAddress string, mockMode bool, ottoHandlerTLS *tlsconfig.ClientOptions) *LogStreamingServer {
 	// Create Otto-handler client
 	ottoHandlerClient := NewOttoHandlerClient(ottoHandlerAddress, mockMode)
+	ottoHandlerClient.SetTLS(ottoHandlerTLS)
 
 	// Connect to Otto-handler if not in mock mode
 	if !mockMode || mockMode { // Always try to connect for now
@@ -91,6 +141,45 @@ func NewLogStreamingServer(k8sClient *k8s.Client, ottoHandlerAddress string, moc
 		streamTimeout:        30 * time.Minute, // 30분 세션 타임아웃
 		maxRetries:           3,                // 최대 3회 재시도
 		retryDelay:           5 * time.Second,  // 5초 재시도 지연
+		loggingConfig: &pb.LoggingConfig{
+			RateLimit:       100,  // 초당 최대 100개 로그
+			BufferSize:      50,   // 50개 로그 버퍼링
+			MaxMessageSize:  1024, // 1KB 최대 메시지 크기
+			IncludeMetadata: true, // 메타데이터 포함
+		},
+	}
+}
+
+// SetLoggingConfig sets the logging limits handed to workers and enforced by the server.
+//
+// SetLoggingConfig는 RegisterWorker에서 Worker에 전달하고 서버에서 적용할 로깅 제한을 설정합니다.
+//   - RateLimit: Worker당 초당 최대 로그 수 (초과 시 DROP, 0이면 무제한)
+//   - BufferSize: 배치 크기이자 순간적으로 허용하는 최대 로그 수
+//   - MaxMessageSize: 메시지 최대 바이트 수 (초과 시 잘라서 전달, 0이면 무제한)
+//
+// 이후 등록되는 Worker부터 적용됩니다.
+func (s *LogStreamingServer) SetLoggingConfig(config *pb.LoggingConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loggingConfig = config
+
+	log.Printf("🚦 Worker 로그 제한: 초당 %d개, 버퍼 %d개, 최대 %d바이트",
+		config.RateLimit, config.BufferSize, config.MaxMessageSize)
+}
+
+// SetWorkerVerifier enables bootstrap token verification for RegisterWorker.
+//
+// SetWorkerVerifier는 RegisterWorker의 부트스트랩 토큰 검증을 설정합니다.
+// required가 false이면 토큰을 보낸 Worker만 검증하고 (이전 Worker 이미지와 호환),
+// true(기본값)이면 토큰 없는 등록과 세션 ID 없는 StreamLogs를 거부합니다.
+func (s *LogStreamingServer) SetWorkerVerifier(verifier WorkerVerifier, required bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workerVerifier = verifier
+	s.requireBootstrap = required && verifier != nil
+
+	if s.requireBootstrap {
+		log.Printf("🔐 Worker 등록에 부트스트랩 토큰 필수")
 	}
 }
 
@@ -113,19 +202,28 @@ func (s *LogStreamingServer) RegisterWorker(ctx context.Context, req *pb.WorkerR
 
 	log.Printf("📋 Worker 등록 요청: worker_id=%s, task_id=%s", req.WorkerId, req.TaskId)
 
+	verified, err := s.verifyWorker(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Check if worker is already registered
+	// 기존 세션 ID는 토큰을 검증한 호출자에게만 돌려줌 (세션 가로채기 방지)
 	for _, session := range s.sessions {
 		if session.WorkerID == req.WorkerId && session.IsActive {
 			log.Printf("⚠️ Worker %s가 이미 등록됨", req.WorkerId)
-			return &pb.RegistrationResponse{
-				Status:    pb.RegistrationResponse_ALREADY_REGISTERED,
-				Message:   fmt.Sprintf("Worker %s is already registered", req.WorkerId),
-				SessionId: session.SessionID,
-				Config:    s.getDefaultLoggingConfig(),
-			}, nil
+			resp := &pb.RegistrationResponse{
+				Status:  pb.RegistrationResponse_ALREADY_REGISTERED,
+				Message: fmt.Sprintf("Worker %s is already registered", req.WorkerId),
+				Config:  s.getDefaultLoggingConfig(),
+			}
+			if verified {
+				resp.SessionId = session.SessionID
+			}
+			return resp, nil
 		}
 	}
 
@@ -140,7 +238,10 @@ func (s *LogStreamingServer) RegisterWorker(ctx context.Context, req *pb.WorkerR
 	}
 
 	// Create new session
-	sessionID := fmt.Sprintf("%s-%d", req.WorkerId, time.Now().UnixNano())
+	sessionID, err := newSessionID(req.WorkerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
+	}
 	session := &StreamingSession{
 		SessionID:          sessionID,
 		WorkerID:           req.WorkerId,
@@ -148,12 +249,14 @@ func (s *LogStreamingServer) RegisterWorker(ctx context.Context, req *pb.WorkerR
 		CreatedAt:          time.Now(),
 		LastActive:         time.Now(),
 		IsActive:           true,
+		Verified:           verified,
 		currentConnections: 0,
 		maxConnections:     3, // 최대 3개 동시 연결
 		retryCount:         0,
 		logStream:          make(chan *pb.LogEntry, 1000),
 		errorStream:        make(chan error, 10),
 		stopChan:           make(chan struct{}),
+		limiter:            newSessionLimiter(s.loggingConfig),
 	}
 
 	s.sessions[sessionID] = session
@@ -243,7 +346,10 @@ func (s *LogStreamingServer) StreamLogs(stream pb.LogStreamingService_StreamLogs
 
 			// Find or validate session
 			if currentSession == nil {
-				session := s.findSessionByWorker(logEntry.WorkerId)
+				session, err := s.bindSession(logEntry)
+				if err != nil {
+					return err
+				}
 				if session == nil {
 					log.Printf("⚠️ Worker %s의 세션을 찾을 수 없음", logEntry.WorkerId)
 					response := &pb.LogResponse{
@@ -259,6 +365,36 @@ func (s *LogStreamingServer) StreamLogs(stream pb.LogStreamingService_StreamLogs
 				}
 				currentSession = session
 				log.Printf("📡 세션과 스트림 연결: %s", session.SessionID)
+			} else if logEntry.WorkerId != currentSession.WorkerID ||
+				(logEntry.SessionId != "" && logEntry.SessionId != currentSession.SessionID) {
+				// 한 스트림은 한 세션의 로그만 전달
+				response := &pb.LogResponse{
+					Status:  pb.LogResponse_DROP,
+					Message: fmt.Sprintf("Log entry does not belong to session %s", currentSession.SessionID),
+				}
+				select {
+				case responseChan <- response:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				continue
+			}
+
+			// Enforce per-worker rate limit
+			if !currentSession.limiter.Allow() {
+				currentSession.mu.Lock()
+				currentSession.DroppedCount++
+				currentSession.mu.Unlock()
+				response := &pb.LogResponse{
+					Status:  pb.LogResponse_DROP,
+					Message: fmt.Sprintf("Rate limit exceeded (%v logs/s)", currentSession.limiter.Limit()),
+				}
+				select {
+				case responseChan <- response:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				continue
 			}
 
 			// Process log entry
@@ -336,6 +472,41 @@ func (s *LogStreamingServer) processLogEntry(ctx context.Context, logEntry *pb.L
 		logEntry.Source = "stdout"
 	}
 
+	// Mask secrets once, before truncation can split a secret and before any forwarding attempt
+	s.redactLogEntry(ctx, logEntry, session)
+
+	// Enforce max_message_size
+	s.mu.RLock()
+	maxMessageSize := int(s.loggingConfig.MaxMessageSize)
+	s.mu.RUnlock()
+	if message, truncated := truncateMessage(logEntry.Message, maxMessageSize); truncated {
+		if logEntry.Metadata == nil {
+			logEntry.Metadata = make(map[string]string)
+		}
+		logEntry.Metadata["truncated"] = "true"
+		logEntry.Metadata["original_size"] = strconv.Itoa(len(logEntry.Message))
+		logEntry.Message = message
+
+		session.mu.Lock()
+		session.TruncatedCount++
+		session.mu.Unlock()
+	}
+
+	// With a spool, ACK once the entry is on disk; the spool replays it to Otto-handler
+	s.mu.RLock()
+	logSpool := s.spool
+	s.mu.RUnlock()
+	if logSpool != nil {
+		if _, err := logSpool.Append(s.toWorkerLogEntry(logEntry, session)); err != nil {
+			session.mu.Lock()
+			session.ErrorCount++
+			session.mu.Unlock()
+			log.Printf("⚠️ 로그 스풀 기록 실패: %v", err)
+			return err
+		}
+		return nil
+	}
+
 	// Attempt to forward to Otto-handler with retry logic
 	if err := s.forwardLogEntryWithRetry(ctx, logEntry, session); err != nil {
 		session.mu.Lock()
@@ -348,6 +519,68 @@ func (s *LogStreamingServer) processLogEntry(ctx context.Context, logEntry *pb.L
 	return nil
 }
 
+// SetSpool makes worker-pushed logs durable by writing them to a disk spool.
+//
+// SetSpool은 Worker가 직접 전송한 로그를 Otto-handler 대신 디스크 스풀에 기록하도록 설정합니다.
+// 스풀에 기록되면 바로 ACK하며, Otto-handler로의 전달과 재시도는 스풀이 담당합니다.
+func (s *LogStreamingServer) SetSpool(logSpool *spool.Spool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spool = logSpool
+}
+
+// SetRedaction configures secret masking for logs pushed by workers.
+//
+// SetRedaction은 Worker가 직접 전송한 로그의 Secret 마스킹을 설정합니다.
+func (s *LogStreamingServer) SetRedaction(settings worker.RedactionSettings) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.redaction = settings
+}
+
+// redactLogEntry masks secrets in a log entry pushed by a worker.
+//
+// redactLogEntry는 Worker Pod에 주입된 Secret 값과 자격 증명 패턴을 마스킹하고
+// 마스킹한 개수를 metadata의 redactions에 기록합니다.
+func (s *LogStreamingServer) redactLogEntry(ctx context.Context, logEntry *pb.LogEntry, session *StreamingSession) {
+	session.redactOnce.Do(func() {
+		s.mu.RLock()
+		settings := s.redaction
+		s.mu.RUnlock()
+		if !settings.Enabled {
+			return
+		}
+
+		var pod *v1.Pod
+		if s.k8sClient != nil {
+			var err error
+			if pod, err = s.k8sClient.GetPod(ctx, session.WorkerID); err != nil {
+				log.Printf("⚠️ Worker %s의 Pod를 조회할 수 없어 패턴만 마스킹합니다: %v", session.WorkerID, err)
+			}
+		}
+		session.redactor = logs.NewRedactor(worker.PodSecretValues(ctx, s.k8sClient, pod), settings.Patterns)
+		session.redact = session.redactor.Stream()
+	})
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	message, redactions := session.redact.Redact(logEntry.Message)
+	logEntry.Message = message
+	for key, value := range logEntry.Metadata {
+		value, n := session.redactor.Redact(value)
+		logEntry.Metadata[key] = value
+		redactions += n
+	}
+
+	if redactions > 0 {
+		if logEntry.Metadata == nil {
+			logEntry.Metadata = make(map[string]string)
+		}
+		logEntry.Metadata["redactions"] = strconv.Itoa(redactions)
+	}
+}
+
 // forwardLogEntryWithRetry forwards log entry with retry logic
 //
 // forwardLogEntryWithRetry는 재시도 로직과 함께 로그 엔트리를 전달합니다.
@@ -385,21 +618,7 @@ func (s *LogStreamingServer) forwardLogEntryWithRetry(ctx context.Context, logEn
 //
 // forwardLogEntry는 단일 로그 엔트리를 Otto-handler로 전달합니다.
 func (s *LogStreamingServer) forwardLogEntry(ctx context.Context, logEntry *pb.LogEntry, session *StreamingSession) error {
-	// Convert LogEntry to WorkerLogEntry for Otto-handler
-	workerLogEntry := &pb.WorkerLogEntry{
-		WorkerId:  logEntry.WorkerId,
-		TaskId:    logEntry.TaskId,
-		Timestamp: logEntry.Timestamp,
-		Level:     logEntry.Level,
-		Source:    logEntry.Source,
-		Message:   logEntry.Message,
-		PodMetadata: &pb.WorkerMetadata{
-			PodName:   logEntry.WorkerId,
-			Namespace: "default", // TODO: Get from actual context
-			CreatedAt: session.CreatedAt.Format(time.RFC3339),
-		},
-		Metadata: logEntry.Metadata,
-	}
+	workerLogEntry := s.toWorkerLogEntry(logEntry, session)
 
 	// Ensure log stream is started for this worker
 	if s.ottoHandlerClient.GetActiveStreamCount() == 0 ||
@@ -418,9 +637,61 @@ func (s *LogStreamingServer) forwardLogEntry(ctx context.Context, logEntry *pb.L
 		return err
 	}
 
+	if s.ottoHandlerClient.BatchingEnabled() {
+		session.mu.Lock()
+		session.BatchedCount++
+		session.mu.Unlock()
+	}
+
 	return nil
 }
 
+// toWorkerLogEntry converts a worker-pushed log entry for Otto-handler.
+//
+// toWorkerLogEntry는 Worker가 전송한 LogEntry를 Otto-handler용 WorkerLogEntry로 변환합니다.
+func (s *LogStreamingServer) toWorkerLogEntry(logEntry *pb.LogEntry, session *StreamingSession) *pb.WorkerLogEntry {
+	return &pb.WorkerLogEntry{
+		WorkerId:  logEntry.WorkerId,
+		TaskId:    logEntry.TaskId,
+		Timestamp: logEntry.Timestamp,
+		Level:     logEntry.Level,
+		Source:    logEntry.Source,
+		Message:   logEntry.Message,
+		PodMetadata: &pb.WorkerMetadata{
+			PodName:   logEntry.WorkerId,
+			Namespace: "default", // TODO: Get from actual context
+			CreatedAt: session.CreatedAt.Format(time.RFC3339),
+		},
+		Metadata: logEntry.Metadata,
+	}
+}
+
+// newSessionLimiter는 LoggingConfig에 따라 Worker 세션의 속도 제한을 생성합니다
+func newSessionLimiter(config *pb.LoggingConfig) *rate.Limiter {
+	if config.RateLimit <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+
+	burst := int(config.BufferSize)
+	if burst <= 0 {
+		burst = int(config.RateLimit)
+	}
+	return rate.NewLimiter(rate.Limit(config.RateLimit), burst)
+}
+
+// truncateMessage는 메시지를 maxBytes 이하로 자릅니다 (UTF-8 문자 경계 유지)
+func truncateMessage(message string, maxBytes int) (string, bool) {
+	if maxBytes <= 0 || len(message) <= maxBytes {
+		return message, false
+	}
+
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+	return message[:cut], true
+}
+
 // isStreamActive checks if a log stream is active for a worker.
 //
 // isStreamActive는 Worker의 로그 스트림이 활성 상태인지 확인합니다.
@@ -434,10 +705,10 @@ func (s *LogStreamingServer) isStreamActive(workerID string) bool {
 // getDefaultLoggingConfig는 기본 로깅 설정을 반환합니다.
 func (s *LogStreamingServer) getDefaultLoggingConfig() *pb.LoggingConfig {
 	return &pb.LoggingConfig{
-		RateLimit:       100,  // 초당 최대 100개 로그
-		BufferSize:      50,   // 50개 로그 버퍼링
-		MaxMessageSize:  1024, // 1KB 최대 메시지 크기
-		IncludeMetadata: true, // 메타데이터 포함
+		RateLimit:       s.loggingConfig.RateLimit,
+		BufferSize:      s.loggingConfig.BufferSize,
+		MaxMessageSize:  s.loggingConfig.MaxMessageSize,
+		IncludeMetadata: s.loggingConfig.IncludeMetadata,
 	}
 }
 
@@ -454,6 +725,82 @@ func (s *LogStreamingServer) countActiveSessionsForWorker(workerID string) int {
 	return count
 }
 
+// verifyWorker checks the bootstrap token of a registration request.
+//
+// verifyWorker는 등록 요청의 부트스트랩 토큰을 검증하고 실패하면 gRPC 에러를 반환합니다.
+func (s *LogStreamingServer) verifyWorker(ctx context.Context, req *pb.WorkerRegistration) (bool, error) {
+	s.mu.RLock()
+	verifier, required := s.workerVerifier, s.requireBootstrap
+	s.mu.RUnlock()
+
+	if verifier == nil {
+		return false, nil
+	}
+	if req.BootstrapToken == "" && !required {
+		log.Printf("⚠️ Worker %s가 부트스트랩 토큰 없이 등록합니다", req.WorkerId)
+		return false, nil
+	}
+
+	err := verifier.VerifyWorker(ctx, req.BootstrapToken, req.WorkerId, req.TaskId)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, worker.ErrInvalidBootstrapToken):
+		log.Printf("🚫 Worker %s 등록 거부: %v", req.WorkerId, err)
+		return false, status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, worker.ErrWorkerMismatch):
+		log.Printf("🚫 Worker %s 등록 거부: %v", req.WorkerId, err)
+		return false, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		log.Printf("❌ Worker %s 확인 실패: %v", req.WorkerId, err)
+		return false, status.Errorf(codes.Unavailable, "failed to verify worker: %v", err)
+	}
+}
+
+// bindSession finds the session a stream's first log entry belongs to.
+//
+// bindSession은 스트림의 첫 로그가 속한 세션을 찾습니다.
+// session_id가 있으면 그 세션을 사용하며 (다른 Worker의 세션이면 PermissionDenied),
+// 없으면 부트스트랩 토큰이 필수일 때 Unauthenticated, 아니면 토큰 없이 등록한 세션만 worker_id로 찾습니다.
+// 세션이 없으면 (nil, nil)을 반환합니다.
+func (s *LogStreamingServer) bindSession(logEntry *pb.LogEntry) (*StreamingSession, error) {
+	s.mu.RLock()
+	required := s.requireBootstrap
+	session, exists := s.sessions[logEntry.SessionId]
+	active := exists && session.IsActive
+	s.mu.RUnlock()
+
+	if logEntry.SessionId == "" {
+		if required {
+			log.Printf("🚫 Worker %s의 로그 스트림에 session_id 없음", logEntry.WorkerId)
+			return nil, status.Error(codes.Unauthenticated, "session_id from RegisterWorker is required")
+		}
+		if session := s.findSessionByWorker(logEntry.WorkerId); session != nil && !session.Verified {
+			return session, nil
+		}
+		return nil, nil
+	}
+
+	if !active {
+		return nil, nil
+	}
+	if session.WorkerID != logEntry.WorkerId {
+		log.Printf("🚫 Worker %s가 다른 Worker의 세션 %s을 사용하려 함", logEntry.WorkerId, logEntry.SessionId)
+		return nil, status.Errorf(codes.PermissionDenied, "session %s does not belong to worker %s",
+			logEntry.SessionId, logEntry.WorkerId)
+	}
+	return session, nil
+}
+
+// newSessionID는 추측할 수 없는 세션 ID를 생성합니다 (<worker_id>-<임의 16바이트 hex>)
+func newSessionID(workerID string) (string, error) {
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return workerID + "-" + hex.EncodeToString(suffix), nil
+}
+
 // findSessionByWorker finds an active session for a worker
 //
 // findSessionByWorker는 특정 Worker의 활성 세션을 찾습니다.
@@ -479,7 +826,10 @@ func (s *LogStreamingServer) deactivateSession(sessionID string) {
 	if session, exists := s.sessions[sessionID]; exists {
 		session.IsActive = false
 		close(session.stopChan)
-		log.Printf("🔌 세션 비활성화: %s (처리된 로그: %d개)", sessionID, session.LogCount)
+		session.mu.RLock()
+		log.Printf("🔌 세션 비활성화: %s (처리된 로그: %d개, 폐기: %d개, 잘림: %d개, 배치 전송: %d개)",
+			sessionID, session.LogCount, session.DroppedCount, session.TruncatedCount, session.BatchedCount)
+		session.mu.RUnlock()
 	}
 }
 
@@ -505,6 +855,80 @@ func (s *LogStreamingServer) CleanupInactiveSessions() {
 	}
 }
 
+// SessionStats is a snapshot of a streaming session's counters.
+//
+// SessionStats는 스트리밍 세션 통계의 스냅샷입니다.
+type SessionStats struct {
+	SessionID      string
+	WorkerID       string
+	TaskID         string
+	Active         bool
+	LogCount       int64
+	ErrorCount     int64
+	DroppedCount   int64
+	TruncatedCount int64
+	BatchedCount   int64
+	LastActive     time.Time
+}
+
+// GetSessionStats returns statistics for all known sessions.
+//
+// GetSessionStats는 모든 세션의 통계를 Worker 이름 순으로 반환합니다.
+func (s *LogStreamingServer) GetSessionStats() []SessionStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := make([]SessionStats, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		session.mu.RLock()
+		stats = append(stats, SessionStats{
+			SessionID:      session.SessionID,
+			WorkerID:       session.WorkerID,
+			TaskID:         session.TaskID,
+			Active:         session.IsActive,
+			LogCount:       session.LogCount,
+			ErrorCount:     session.ErrorCount,
+			DroppedCount:   session.DroppedCount,
+			TruncatedCount: session.TruncatedCount,
+			BatchedCount:   session.BatchedCount,
+			LastActive:     session.LastActive,
+		})
+		session.mu.RUnlock()
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].WorkerID != stats[j].WorkerID {
+			return stats[i].WorkerID < stats[j].WorkerID
+		}
+		return stats[i].SessionID < stats[j].SessionID
+	})
+	return stats
+}
+
+// LogSessionStats writes the counters of every known session to the log.
+//
+// LogSessionStats는 모든 세션의 통계를 로그로 남깁니다.
+// 정리 주기마다 호출되어 폐기, 잘림, 배치 전송 수를 세션 종료 전에도 확인할 수 있습니다.
+func (s *LogStreamingServer) LogSessionStats() {
+	stats := s.GetSessionStats()
+	if len(stats) == 0 {
+		return
+	}
+
+	var total SessionStats
+	for _, stat := range stats {
+		log.Printf("📊 세션 통계: %s (worker=%s, 활성=%v, 처리: %d개, 오류: %d개, 폐기: %d개, 잘림: %d개, 배치 전송: %d개, 마지막 활동: %s)",
+			stat.SessionID, stat.WorkerID, stat.Active, stat.LogCount, stat.ErrorCount,
+			stat.DroppedCount, stat.TruncatedCount, stat.BatchedCount, stat.LastActive.Format(time.RFC3339))
+		total.LogCount += stat.LogCount
+		total.DroppedCount += stat.DroppedCount
+		total.TruncatedCount += stat.TruncatedCount
+		total.BatchedCount += stat.BatchedCount
+	}
+	log.Printf("📊 세션 %d개 합계: 처리 %d개, 폐기 %d개, 잘림 %d개, 배치 전송 %d개",
+		len(stats), total.LogCount, total.DroppedCount, total.TruncatedCount, total.BatchedCount)
+}
+
 // GetActiveSessionsCount returns the number of active sessions
 //
 // GetActiveSessionsCount는 활성 세션 수를 반환합니다.
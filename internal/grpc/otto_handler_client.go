@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -18,12 +19,23 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
+	"github.com/Team-5-CodeCat/ottoscaler/internal/tlsconfig"
 	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
 )
 
+const (
+	// DefaultLogBatchSize는 Otto-handler로 한 번에 보내는 최대 로그 엔트리 수의 기본값입니다
+	DefaultLogBatchSize = 50
+	// DefaultLogBatchInterval은 배치가 가득 차지 않아도 전송하는 간격의 기본값입니다
+	DefaultLogBatchInterval = 1 * time.Second
+)
+
 // OttoHandlerClient manages connection to Otto-handler for log forwarding.
 //
 // OttoHandlerClient는 Otto-handler로 로그를 전달하기 위한 gRPC 클라이언트입니다.
+//
+// 락 순서: mu를 잡은 채 streamMu를 잡을 수 있지만 (Disconnect), streamMu를 잡은 채
+// mu를 잡아서는 안 됩니다. 스트림을 시작할 때는 연결과 클라이언트 조회를 먼저 끝냅니다.
 type OttoHandlerClient struct {
 	// Connection management
 	address     string
@@ -33,6 +45,10 @@ type OttoHandlerClient struct {
 	isConnected bool
 	mu          sync.RWMutex
 
+	// TLS (nil이면 평문 연결), stopTLS는 인증서 파일 감시를 멈춤
+	tls     *tlsconfig.ClientOptions
+	stopTLS context.CancelFunc
+
 	// Streaming management
 	activeStreams map[string]*LogStream
 	streamMu      sync.RWMutex
@@ -42,21 +58,49 @@ type OttoHandlerClient struct {
 	retryDelay     time.Duration
 	connectTimeout time.Duration
 	streamTimeout  time.Duration
+
+	// Batching (batchSize가 1이면 엔트리마다 ForwardWorkerLogs로 전송)
+	batchSize     int
+	batchInterval time.Duration
+
+	// Flow control (Worker별 ACK 없이 보낼 수 있는 최대 엔트리 수)
+	maxInFlight int
+
+	// Multiplexing (0이면 Worker마다 스트림, 그 외에는 공유 스트림 풀)
+	multiplexStreams int
+	mux              *logMux
 }
 
 // LogStream represents an active log streaming session.
 //
 // LogStream은 활성 로그 스트리밍 세션을 나타냅니다.
+// 카운터는 전송 호출자, 재전송, 응답 수신, 배치 타이머 goroutine이 함께 갱신하므로 atomic으로 접근합니다.
 type LogStream struct {
 	TaskID     string
 	WorkerID   string
 	Stream     pb.OttoHandlerLogService_ForwardWorkerLogsClient
 	Context    context.Context
 	Cancel     context.CancelFunc
-	LogCount   int64
-	ErrorCount int64
+	LogCount   atomic.Int64
+	ErrorCount atomic.Int64
 	CreatedAt  time.Time
-	LastActive time.Time
+	lastActive atomic.Int64 // UnixNano
+
+	// Batching
+	BatchStream pb.OttoHandlerLogService_ForwardWorkerLogBatchesClient
+	BatchCount  atomic.Int64 // 전송한 배치 수
+
+	batchMu    sync.Mutex
+	pending    []*pb.WorkerLogEntry
+	flushTimer *time.Timer
+	sequence   int64
+
+	// Flow control (in-flight 추적, 재전송, throttle)
+	flow   *logFlow
+	sendMu sync.Mutex // gRPC 스트림의 Send는 동시에 호출할 수 없음
+
+	// Multiplexing (공유 스트림 풀을 사용하면 Stream/BatchStream 대신 설정됨)
+	mux *muxStream
 }
 
 // NewOttoHandlerClient creates a new Otto-handler gRPC client.
@@ -71,9 +115,90 @@ func NewOttoHandlerClient(address string, mockMode bool) *OttoHandlerClient {
 		retryDelay:     5 * time.Second,
 		connectTimeout: 10 * time.Second,
 		streamTimeout:  30 * time.Minute,
+		batchSize:      DefaultLogBatchSize,
+		batchInterval:  DefaultLogBatchInterval,
+		maxInFlight:    DefaultMaxInFlight,
+	}
+}
+
+// SetBatching configures how log entries are grouped into WorkerLogBatch messages.
+//
+// SetBatching은 로그 엔트리를 WorkerLogBatch로 묶는 방식을 설정합니다.
+// 배치는 size개가 모이거나 interval이 지나면 전송되며, size가 1이면 배치 없이
+// 엔트리마다 ForwardWorkerLogs로 전송합니다. 이후 시작되는 스트림부터 적용됩니다.
+func (c *OttoHandlerClient) SetBatching(size int, interval time.Duration) {
+	if size <= 0 {
+		size = DefaultLogBatchSize
+	}
+	if interval <= 0 {
+		interval = DefaultLogBatchInterval
+	}
+
+	c.streamMu.Lock()
+	c.batchSize = size
+	c.batchInterval = interval
+	c.streamMu.Unlock()
+
+	if size > 1 {
+		log.Printf("📦 Otto-handler 로그 배치 전송: 최대 %d개 / %v", size, interval)
+	} else {
+		log.Printf("📤 Otto-handler 로그 배치 비활성화 (엔트리마다 전송)")
 	}
 }
 
+// SetMaxInFlight limits how many log entries a worker may have unacknowledged.
+//
+// SetMaxInFlight는 Worker별로 ACK를 받지 못한 채 보낼 수 있는 최대 엔트리 수를 설정합니다.
+// 한도에 도달하면 ACK가 올 때까지 전송이 대기합니다. 이후 시작되는 스트림부터 적용됩니다.
+func (c *OttoHandlerClient) SetMaxInFlight(maxInFlight int) {
+	if maxInFlight <= 0 {
+		maxInFlight = DefaultMaxInFlight
+	}
+
+	c.streamMu.Lock()
+	c.maxInFlight = maxInFlight
+	c.streamMu.Unlock()
+
+	log.Printf("🚦 Otto-handler 로그 흐름 제어: Worker당 in-flight 최대 %d개", maxInFlight)
+}
+
+// SetMultiplexing sends the logs of all workers over a fixed pool of shared streams.
+//
+// SetMultiplexing은 모든 Worker의 로그를 streams개의 공유 스트림으로 묶어 전송하도록 설정합니다.
+// Worker는 가장 한가한 스트림에 고정되어 순서가 유지되고, 스트림은 Worker 사이를
+// 라운드 로빈으로 돌며 전송합니다. 0이면 Worker마다 스트림을 엽니다.
+// 연결 전에 호출해야 하며, 이후 시작되는 스트림부터 적용됩니다.
+func (c *OttoHandlerClient) SetMultiplexing(streams int) {
+	if streams < 0 {
+		streams = 0
+	}
+
+	c.streamMu.Lock()
+	c.multiplexStreams = streams
+	c.streamMu.Unlock()
+
+	if streams > 0 {
+		log.Printf("🔀 Otto-handler 로그 멀티플렉싱: 공유 스트림 %d개", streams)
+	}
+}
+
+// SetTLS configures TLS (and optionally mutual TLS) for the Otto-handler connection.
+//
+// SetTLS는 Otto-handler 연결에 사용할 TLS 설정을 지정합니다 (nil이면 평문).
+// 클라이언트 인증서와 CA 파일은 바뀌면 재시작 없이 다시 읽습니다. Connect 전에 호출해야 합니다.
+func (c *OttoHandlerClient) SetTLS(opts *tlsconfig.ClientOptions) {
+	c.mu.Lock()
+	c.tls = opts
+	c.mu.Unlock()
+}
+
+// BatchingEnabled는 로그 엔트리를 배치로 전송하는지 반환합니다
+func (c *OttoHandlerClient) BatchingEnabled() bool {
+	c.streamMu.RLock()
+	defer c.streamMu.RUnlock()
+	return c.batchSize > 1
+}
+
 // Connect establishes connection to Otto-handler.
 //
 // Connect는 Otto-handler와의 연결을 설정합니다.
@@ -92,16 +217,27 @@ func (c *OttoHandlerClient) Connect(ctx context.Context) error {
 	}
 
 	// Real connection
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()), // TODO: Add TLS in production
+	creds := insecure.NewCredentials()
+	stopTLS := context.CancelFunc(func() {})
+	if c.tls != nil {
+		tlsCreds, reloader, err := tlsconfig.ClientCredentials(*c.tls)
+		if err != nil {
+			return fmt.Errorf("failed to configure TLS for Otto-handler: %w", err)
+		}
+		var watchCtx context.Context
+		watchCtx, stopTLS = context.WithCancel(context.Background())
+		go reloader.Run(watchCtx, tlsconfig.DefaultReloadInterval)
+		creds = tlsCreds
 	}
 
-	conn, err := grpc.NewClient(c.address, opts...)
+	conn, err := grpc.NewClient(c.address, grpc.WithTransportCredentials(creds))
 	if err != nil {
+		stopTLS()
 		return fmt.Errorf("failed to connect to Otto-handler at %s: %w", c.address, err)
 	}
 
 	c.conn = conn
+	c.stopTLS = stopTLS
 	c.client = pb.NewOttoHandlerLogServiceClient(conn)
 	c.isConnected = true
 
@@ -123,12 +259,23 @@ func (c *OttoHandlerClient) Disconnect() error {
 	// Close all active streams
 	c.closeAllStreams()
 
+	c.streamMu.Lock()
+	if c.mux != nil {
+		c.mux.close()
+		c.mux = nil
+	}
+	c.streamMu.Unlock()
+
 	if c.mockMode {
 		log.Printf("🔌 [MOCK] Otto-handler 연결 해제")
 		c.isConnected = false
 		return nil
 	}
 
+	if c.stopTLS != nil {
+		c.stopTLS()
+		c.stopTLS = nil
+	}
 	if c.conn != nil {
 		if err := c.conn.Close(); err != nil {
 			return fmt.Errorf("failed to close connection: %w", err)
@@ -144,6 +291,20 @@ func (c *OttoHandlerClient) Disconnect() error {
 //
 // StartLogStream은 Worker를 위한 새로운 로그 스트리밍 세션을 시작합니다.
 func (c *OttoHandlerClient) StartLogStream(ctx context.Context, workerID string, taskID string) error {
+	// 아직 연결되지 않았으면 (시작 시 연결 실패 등) 먼저 연결 (streamMu를 잡기 전에, 락 순서 참고)
+	var client pb.OttoHandlerLogServiceClient
+	if !c.mockMode {
+		if err := c.Connect(ctx); err != nil {
+			return err
+		}
+		c.mu.RLock()
+		client = c.client
+		c.mu.RUnlock()
+		if client == nil {
+			return fmt.Errorf("not connected to Otto-handler")
+		}
+	}
+
 	c.streamMu.Lock()
 	defer c.streamMu.Unlock()
 
@@ -160,35 +321,58 @@ func (c *OttoHandlerClient) StartLogStream(ctx context.Context, workerID string,
 		log.Printf("📡 [MOCK] Worker %s의 로그 스트림 시작 (task: %s)", workerID, taskID)
 
 		stream := &LogStream{
-			TaskID:     taskID,
-			WorkerID:   workerID,
-			Context:    streamCtx,
-			Cancel:     cancel,
-			CreatedAt:  time.Now(),
-			LastActive: time.Now(),
+			TaskID:    taskID,
+			WorkerID:  workerID,
+			Context:   streamCtx,
+			Cancel:    cancel,
+			CreatedAt: time.Now(),
+			flow:      newLogFlow(c.maxInFlight),
 		}
+		stream.touch()
 
 		c.activeStreams[workerID] = stream
 		return nil
 	}
 
+	logStream := &LogStream{
+		TaskID:    taskID,
+		WorkerID:  workerID,
+		Context:   streamCtx,
+		Cancel:    cancel,
+		CreatedAt: time.Now(),
+		flow:      newLogFlow(c.maxInFlight),
+	}
+	logStream.touch()
+
+	// Shared stream pool
+	if c.multiplexStreams > 0 {
+		if c.mux == nil {
+			c.mux = newLogMux(c, c.multiplexStreams, c.batchSize > 1)
+		}
+		if err := c.mux.attach(logStream, client); err != nil {
+			cancel()
+			return fmt.Errorf("failed to attach log stream: %w", err)
+		}
+
+		c.activeStreams[workerID] = logStream
+		context.AfterFunc(streamCtx, func() { c.releaseLogStream(logStream) })
+
+		log.Printf("📡 Worker %s의 로그 스트림 시작 (task: %s, 공유 스트림 #%d)", workerID, taskID, logStream.mux.index)
+		return nil
+	}
+
 	// Real stream
-	stream, err := c.client.ForwardWorkerLogs(streamCtx)
+	var err error
+	if c.batchSize > 1 {
+		logStream.BatchStream, err = client.ForwardWorkerLogBatches(streamCtx)
+	} else {
+		logStream.Stream, err = client.ForwardWorkerLogs(streamCtx)
+	}
 	if err != nil {
 		cancel()
 		return fmt.Errorf("failed to create log stream: %w", err)
 	}
 
-	logStream := &LogStream{
-		TaskID:     taskID,
-		WorkerID:   workerID,
-		Stream:     stream,
-		Context:    streamCtx,
-		Cancel:     cancel,
-		CreatedAt:  time.Now(),
-		LastActive: time.Now(),
-	}
-
 	c.activeStreams[workerID] = logStream
 
 	// Start response handler
@@ -201,9 +385,13 @@ func (c *OttoHandlerClient) StartLogStream(ctx context.Context, workerID string,
 // ForwardLogEntry forwards a single log entry to Otto-handler.
 //
 // ForwardLogEntry는 단일 로그 엔트리를 Otto-handler로 전달합니다.
+// 배치가 활성화되어 있으면 엔트리는 Worker별 배치에 추가되고,
+// 배치가 가득 차거나 전송 간격이 지나면 함께 전송됩니다.
+// ACK를 기다리는 엔트리가 한도에 도달했거나 throttle 중이면 전송할 수 있을 때까지 대기합니다.
 func (c *OttoHandlerClient) ForwardLogEntry(ctx context.Context, entry *pb.WorkerLogEntry) error {
 	c.streamMu.RLock()
 	stream, exists := c.activeStreams[entry.WorkerId]
+	batchSize, batchInterval := c.batchSize, c.batchInterval
 	c.streamMu.RUnlock()
 
 	if !exists {
@@ -211,8 +399,12 @@ func (c *OttoHandlerClient) ForwardLogEntry(ctx context.Context, entry *pb.Worke
 	}
 
 	// Update activity
-	stream.LastActive = time.Now()
-	stream.LogCount++
+	stream.touch()
+	stream.LogCount.Add(1)
+
+	if batchSize > 1 {
+		return c.addToBatch(ctx, stream, entry, batchSize, batchInterval)
+	}
 
 	if c.mockMode {
 		// Mock forwarding with simulated delay
@@ -227,21 +419,137 @@ func (c *OttoHandlerClient) ForwardLogEntry(ctx context.Context, entry *pb.Worke
 	}
 
 	// Real forwarding
-	if err := stream.Stream.Send(entry); err != nil {
-		stream.ErrorCount++
+	seq := stream.flow.sequenceFor(entry)
+	if err := stream.flow.reserve(ctx, stream.Context, seq, &inFlightItem{entry: entry, size: 1}); err != nil {
+		stream.ErrorCount.Add(1)
 		return fmt.Errorf("failed to send log entry: %w", err)
 	}
+	if err := c.send(ctx, stream, &inFlightItem{entry: entry}); err != nil {
+		stream.flow.release(seq)
+		stream.ErrorCount.Add(1)
+		return fmt.Errorf("failed to send log entry: %w", err)
+	}
+
+	return nil
+}
+
+// addToBatch는 엔트리를 배치에 추가하고, 가득 차면 바로 전송합니다
+func (c *OttoHandlerClient) addToBatch(ctx context.Context, stream *LogStream, entry *pb.WorkerLogEntry, batchSize int, batchInterval time.Duration) error {
+	stream.batchMu.Lock()
+	defer stream.batchMu.Unlock()
+
+	stream.pending = append(stream.pending, entry)
+	if len(stream.pending) >= batchSize {
+		return c.flushBatchLocked(stream)
+	}
+
+	if stream.flushTimer == nil {
+		stream.flushTimer = time.AfterFunc(batchInterval, func() {
+			stream.batchMu.Lock()
+			defer stream.batchMu.Unlock()
+
+			stream.flushTimer = nil
+			if err := c.flushBatchLocked(stream); err != nil {
+				log.Printf("⚠️ Worker %s의 로그 배치 전송 실패: %v", stream.WorkerID, err)
+			}
+		})
+	}
+	return nil
+}
+
+// flushBatch는 대기 중인 배치를 전송합니다
+func (c *OttoHandlerClient) flushBatch(stream *LogStream) error {
+	stream.batchMu.Lock()
+	defer stream.batchMu.Unlock()
+	return c.flushBatchLocked(stream)
+}
+
+// flushBatchLocked는 batchMu를 잡은 상태에서 대기 중인 엔트리를 WorkerLogBatch로 전송합니다
+func (c *OttoHandlerClient) flushBatchLocked(stream *LogStream) error {
+	if stream.flushTimer != nil {
+		stream.flushTimer.Stop()
+		stream.flushTimer = nil
+	}
+	if len(stream.pending) == 0 {
+		return nil
+	}
+
+	stream.sequence++
+	batch := &pb.WorkerLogBatch{
+		WorkerId: stream.WorkerID,
+		TaskId:   stream.TaskID,
+		Sequence: stream.sequence,
+		Entries:  stream.pending,
+	}
+	stream.pending = nil
+	stream.BatchCount.Add(1)
+
+	if c.mockMode {
+		log.Printf("📦 [MOCK] 로그 배치 전달 [%s] #%d: %d개", batch.WorkerId, batch.Sequence, len(batch.Entries))
+		for _, entry := range batch.Entries {
+			log.Printf("📤 [MOCK] 로그 전달 [%s|%s] %s: %s",
+				entry.WorkerId, entry.TaskId, entry.Level, entry.Message)
+		}
+		return nil
+	}
 
+	if stream.BatchStream == nil {
+		stream.ErrorCount.Add(1)
+		return fmt.Errorf("no batch stream for worker %s", stream.WorkerID)
+	}
+	item := &inFlightItem{batch: batch, size: len(batch.Entries)}
+	if err := stream.flow.reserve(stream.Context, stream.Context, batch.Sequence, item); err != nil {
+		stream.flow.markFailed(item.size)
+		stream.ErrorCount.Add(1)
+		return fmt.Errorf("failed to send log batch: %w", err)
+	}
+	if err := c.send(stream.Context, stream, item); err != nil {
+		stream.flow.fail(batch.Sequence)
+		stream.ErrorCount.Add(1)
+		return fmt.Errorf("failed to send log batch: %w", err)
+	}
 	return nil
 }
 
+// send는 throttle 토큰을 받은 뒤 엔트리 또는 배치를 스트림으로 전송합니다
+func (c *OttoHandlerClient) send(ctx context.Context, stream *LogStream, item *inFlightItem) error {
+	if err := stream.flow.acquire(ctx); err != nil {
+		return err
+	}
+
+	if stream.mux != nil {
+		return stream.mux.enqueue(ctx, stream, item)
+	}
+
+	stream.sendMu.Lock()
+	defer stream.sendMu.Unlock()
+
+	if item.batch != nil {
+		return stream.BatchStream.Send(item.batch)
+	}
+	return stream.Stream.Send(item.entry)
+}
+
+// resend는 Otto-handler가 RETRY로 응답한 엔트리 또는 배치를 다시 전송합니다
+func (c *OttoHandlerClient) resend(stream *LogStream, seq int64, item *inFlightItem) {
+	if err := c.send(stream.Context, stream, item); err != nil {
+		if stream.Context.Err() == nil {
+			log.Printf("❌ Worker %s의 로그 재전송 실패 (sequence %d): %v", stream.WorkerID, seq, err)
+		}
+		stream.flow.fail(seq)
+		stream.ErrorCount.Add(1)
+	}
+}
+
 // handleStreamResponses handles responses from Otto-handler.
 //
 // handleStreamResponses는 Otto-handler로부터의 응답을 처리합니다.
 func (c *OttoHandlerClient) handleStreamResponses(stream *LogStream) {
 	defer func() {
 		c.streamMu.Lock()
-		delete(c.activeStreams, stream.WorkerID)
+		if c.activeStreams[stream.WorkerID] == stream {
+			delete(c.activeStreams, stream.WorkerID)
+		}
 		c.streamMu.Unlock()
 		stream.Cancel()
 		log.Printf("📡 Worker %s의 로그 스트림 종료", stream.WorkerID)
@@ -252,7 +560,13 @@ func (c *OttoHandlerClient) handleStreamResponses(stream *LogStream) {
 		case <-stream.Context.Done():
 			return
 		default:
-			resp, err := stream.Stream.Recv()
+			var resp *pb.LogForwardResponse
+			var err error
+			if stream.BatchStream != nil {
+				resp, err = stream.BatchStream.Recv()
+			} else {
+				resp, err = stream.Stream.Recv()
+			}
 			if err == io.EOF {
 				log.Printf("📡 Otto-handler가 Worker %s의 스트림을 종료함", stream.WorkerID)
 				return
@@ -273,22 +587,40 @@ func (c *OttoHandlerClient) handleStreamResponses(stream *LogStream) {
 // processResponse processes a response from Otto-handler.
 //
 // processResponse는 Otto-handler로부터의 응답을 처리합니다.
+// 응답의 sequence로 in-flight 항목을 찾아 ACK는 해제하고, RETRY는 다시 전송하며,
+// DROP은 포기합니다. throttle_ms는 수신이 아닌 전송 경로의 토큰 버킷에 적용됩니다.
 func (c *OttoHandlerClient) processResponse(stream *LogStream, resp *pb.LogForwardResponse) {
-	switch resp.Status {
-	case pb.LogForwardResponse_ACK:
-		// Success - no action needed
+	if stream.flow.setThrottle(resp.ThrottleMs) {
 		if resp.ThrottleMs > 0 {
-			// Apply throttling if requested
-			time.Sleep(time.Duration(resp.ThrottleMs) * time.Millisecond)
+			log.Printf("🐢 Otto-handler가 Worker %s의 전송 간격을 %dms로 제한", stream.WorkerID, resp.ThrottleMs)
+		} else {
+			log.Printf("🚀 Otto-handler가 Worker %s의 전송 제한을 해제", stream.WorkerID)
 		}
+	}
+
+	switch resp.Status {
+	case pb.LogForwardResponse_ACK:
+		stream.flow.release(resp.Sequence)
 
 	case pb.LogForwardResponse_RETRY:
-		log.Printf("⚠️ Otto-handler가 Worker %s의 재시도를 요청: %s", stream.WorkerID, resp.Message)
-		stream.ErrorCount++
+		item, exhausted := stream.flow.retry(resp.Sequence)
+		switch {
+		case exhausted:
+			log.Printf("❌ Worker %s의 로그 재전송 횟수 초과로 폐기 (sequence %d): %s",
+				stream.WorkerID, resp.Sequence, resp.Message)
+			stream.ErrorCount.Add(1)
+		case item == nil:
+			log.Printf("⚠️ Otto-handler가 알 수 없는 sequence %d의 재시도를 요청 (Worker %s)", resp.Sequence, stream.WorkerID)
+		default:
+			log.Printf("🔁 Otto-handler가 Worker %s의 재시도를 요청 (sequence %d, %d번째): %s",
+				stream.WorkerID, resp.Sequence, item.attempts, resp.Message)
+			go c.resend(stream, resp.Sequence, item)
+		}
 
 	case pb.LogForwardResponse_DROP:
-		log.Printf("❌ Otto-handler가 Worker %s의 로그를 폐기: %s", stream.WorkerID, resp.Message)
-		stream.ErrorCount++
+		log.Printf("❌ Otto-handler가 Worker %s의 로그를 폐기 (sequence %d): %s", stream.WorkerID, resp.Sequence, resp.Message)
+		stream.flow.drop(resp.Sequence)
+		stream.ErrorCount.Add(1)
 	}
 }
 
@@ -325,31 +657,83 @@ func (c *OttoHandlerClient) NotifyWorkerStatus(ctx context.Context, notification
 // CloseLogStream closes the log stream for a specific worker.
 //
 // CloseLogStream은 특정 Worker의 로그 스트림을 종료합니다.
+// 남은 배치를 전송하고 in-flight 로그의 ACK(재전송 포함)를 잠시 기다린 뒤 닫습니다.
 func (c *OttoHandlerClient) CloseLogStream(workerID string) error {
 	c.streamMu.Lock()
-	defer c.streamMu.Unlock()
+	stream, exists := c.activeStreams[workerID]
+	if exists {
+		delete(c.activeStreams, workerID)
+	}
+	c.streamMu.Unlock()
 
+	if !exists {
+		return nil
+	}
+
+	c.finishLogStream(stream)
+	return nil
+}
+
+// FlushLogStream sends any batched entries of a worker and waits until
+// otto-handler has acknowledged everything sent on its stream.
+//
+// FlushLogStream은 Worker의 남은 배치를 전송하고, 스트림으로 보낸 엔트리가 모두
+// ACK(또는 Otto-handler의 DROP)될 때까지 기다립니다. ACK 없이 끝나거나 전송에 실패한
+// 엔트리가 있으면 에러를 반환하므로, 스풀은 에러가 없을 때만 전달 위치를 저장합니다.
+func (c *OttoHandlerClient) FlushLogStream(ctx context.Context, workerID string) error {
+	c.streamMu.RLock()
 	stream, exists := c.activeStreams[workerID]
+	c.streamMu.RUnlock()
+
 	if !exists {
+		return fmt.Errorf("no active stream for worker %s", workerID)
+	}
+	if err := c.flushBatch(stream); err != nil {
+		return err
+	}
+	if c.mockMode {
 		return nil
 	}
 
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(stream.Context, cancel)
+	defer stop()
+
+	if unacked := stream.flow.drain(waitCtx, inFlightWaitTimeout); unacked > 0 {
+		return fmt.Errorf("%d log entries of worker %s were not acknowledged by otto-handler", unacked, workerID)
+	}
+	if failed := stream.flow.takeFailed(); failed > 0 {
+		return fmt.Errorf("%d log entries of worker %s failed to send", failed, workerID)
+	}
+	return nil
+}
+
+// finishLogStream은 남은 배치를 전송하고 ACK를 기다린 뒤 스트림을 닫습니다
+func (c *OttoHandlerClient) finishLogStream(stream *LogStream) {
+	workerID := stream.WorkerID
+
+	// 남은 배치를 먼저 전송
+	if err := c.flushBatch(stream); err != nil {
+		log.Printf("⚠️ Worker %s의 마지막 로그 배치 전송 실패: %v", workerID, err)
+	}
+
 	if c.mockMode {
-		log.Printf("📡 [MOCK] Worker %s의 로그 스트림 종료 (전송된 로그: %d개, 오류: %d개)",
-			workerID, stream.LogCount, stream.ErrorCount)
+		log.Printf("📡 [MOCK] Worker %s의 로그 스트림 종료 (전송된 로그: %d개, 배치: %d개, 오류: %d개)",
+			workerID, stream.LogCount.Load(), stream.BatchCount.Load(), stream.ErrorCount.Load())
 	} else {
-		if stream.Stream != nil {
-			if err := stream.Stream.CloseSend(); err != nil {
-				log.Printf("⚠️ Worker %s의 스트림 종료 오류: %v", workerID, err)
-			}
+		if unacked := stream.flow.drain(stream.Context, closeDrainTimeout); unacked > 0 {
+			log.Printf("⚠️ Worker %s의 로그 %d개가 ACK 없이 스트림이 종료됨", workerID, unacked)
 		}
-		log.Printf("📡 Worker %s의 로그 스트림 종료 (전송된 로그: %d개, 오류: %d개)",
-			workerID, stream.LogCount, stream.ErrorCount)
+		if err := closeLogStreamSend(stream); err != nil {
+			log.Printf("⚠️ Worker %s의 스트림 종료 오류: %v", workerID, err)
+		}
+		_, retried, dropped := stream.flow.stats()
+		log.Printf("📡 Worker %s의 로그 스트림 종료 (전송된 로그: %d개, 배치: %d개, 재전송: %d회, 폐기: %d개, 오류: %d개)",
+			workerID, stream.LogCount.Load(), stream.BatchCount.Load(), retried, dropped, stream.ErrorCount.Load())
 	}
 
 	stream.Cancel()
-	delete(c.activeStreams, workerID)
-	return nil
 }
 
 // closeAllStreams closes all active log streams.
@@ -357,17 +741,46 @@ func (c *OttoHandlerClient) CloseLogStream(workerID string) error {
 // closeAllStreams는 모든 활성 로그 스트림을 종료합니다.
 func (c *OttoHandlerClient) closeAllStreams() {
 	c.streamMu.Lock()
-	defer c.streamMu.Unlock()
+	streams := c.activeStreams
+	c.activeStreams = make(map[string]*LogStream)
+	c.streamMu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, stream := range streams {
+		wg.Add(1)
+		go func(stream *LogStream) {
+			defer wg.Done()
+			c.finishLogStream(stream)
+		}(stream)
+	}
+	wg.Wait()
+}
 
-	for workerID, stream := range c.activeStreams {
-		if stream.Stream != nil && !c.mockMode {
-			stream.Stream.CloseSend()
-		}
-		stream.Cancel()
-		log.Printf("📡 Worker %s의 로그 스트림 종료", workerID)
+// releaseLogStream은 공유 스트림을 사용하는 Worker 스트림이 끝나면 목록과 풀에서 제거합니다
+func (c *OttoHandlerClient) releaseLogStream(stream *LogStream) {
+	c.streamMu.Lock()
+	if c.activeStreams[stream.WorkerID] == stream {
+		delete(c.activeStreams, stream.WorkerID)
 	}
+	c.streamMu.Unlock()
 
-	c.activeStreams = make(map[string]*LogStream)
+	stream.mux.detach(stream)
+}
+
+// closeLogStreamSend는 사용 중인 스트림(단일/배치)의 전송 방향을 닫습니다.
+// 공유 스트림이면 Worker만 분리하고 스트림은 유지합니다.
+func closeLogStreamSend(stream *LogStream) error {
+	if stream.mux != nil {
+		stream.mux.detach(stream)
+		return nil
+	}
+	if stream.BatchStream != nil {
+		return stream.BatchStream.CloseSend()
+	}
+	if stream.Stream != nil {
+		return stream.Stream.CloseSend()
+	}
+	return nil
 }
 
 // IsConnected returns whether the client is connected.
@@ -389,6 +802,31 @@ func (c *OttoHandlerClient) IsConnected() bool {
 	return c.conn.GetState() == connectivity.Ready
 }
 
+// CheckConnection reports whether otto-handler is reachable, for readiness checks.
+//
+// CheckConnection은 헬스 체크용으로 Otto-handler 연결 상태를 확인합니다.
+// IsConnected를 기준으로 하되, 유휴(IDLE) 연결은 요청이 오면 다시 연결되므로 정상으로 보고
+// 연결을 시작시켜 Otto-handler 장애가 다음 확인에서 드러나도록 합니다.
+func (c *OttoHandlerClient) CheckConnection() error {
+	if c.IsConnected() {
+		return nil
+	}
+
+	c.mu.RLock()
+	conn, connected := c.conn, c.isConnected
+	c.mu.RUnlock()
+
+	if !connected || conn == nil {
+		return fmt.Errorf("not connected to otto-handler at %s", c.address)
+	}
+	state := conn.GetState()
+	if state == connectivity.Idle {
+		conn.Connect()
+		return nil
+	}
+	return fmt.Errorf("otto-handler connection to %s is %s", c.address, state)
+}
+
 // GetActiveStreamCount returns the number of active log streams.
 //
 // GetActiveStreamCount는 활성 로그 스트림 수를 반환합니다.
@@ -398,6 +836,18 @@ func (c *OttoHandlerClient) GetActiveStreamCount() int {
 	return len(c.activeStreams)
 }
 
+// touch는 스트림의 마지막 활동 시간을 현재 시간으로 갱신합니다
+func (s *LogStream) touch() {
+	s.lastActive.Store(time.Now().UnixNano())
+}
+
+// LastActive returns when an entry was last forwarded on the stream.
+//
+// LastActive는 스트림으로 마지막 엔트리를 전달한 시간을 반환합니다.
+func (s *LogStream) LastActive() time.Time {
+	return time.Unix(0, s.lastActive.Load())
+}
+
 // GetStreamStats returns statistics for a specific worker's stream.
 //
 // GetStreamStats는 특정 Worker 스트림의 통계를 반환합니다.
@@ -410,5 +860,5 @@ func (c *OttoHandlerClient) GetStreamStats(workerID string) (logCount, errorCoun
 		return 0, 0, false
 	}
 
-	return stream.LogCount, stream.ErrorCount, true
+	return stream.LogCount.Load(), stream.ErrorCount.Load(), true
 }
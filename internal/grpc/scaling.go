@@ -20,12 +20,13 @@ import (
 // createWorkerConfigs creates worker configurations based on scale request.
 //
 // createWorkerConfigs는 스케일 요청을 기반으로 Worker 설정을 생성합니다.
-func (s *Server) createWorkerConfigs(req *pb.ScaleRequest) []worker.WorkerConfig {
+// Pod 이름에는 Task 부모 오브젝트의 시도 번호가 포함되므로 같은 Task를
+// 다시 요청해도 이전 Pod와 이름이 충돌하지 않습니다.
+func (s *Server) createWorkerConfigs(req *pb.ScaleRequest, owner *worker.RunOwner) []worker.WorkerConfig {
 	configs := make([]worker.WorkerConfig, req.WorkerCount)
 
 	for i := int32(0); i < req.WorkerCount; i++ {
-		workerID := fmt.Sprintf("otto-agent-%s-%d",
-			sanitizeTaskID(req.TaskId), i+1)
+		workerID := worker.WorkerPodName("otto-agent-"+req.TaskId, owner.Attempt, int(i+1))
 
 		// Build worker labels
 		workerLabels := s.config.GetWorkerLabels(map[string]string{
@@ -47,6 +48,11 @@ func (s *Server) createWorkerConfigs(req *pb.ScaleRequest) []worker.WorkerConfig
 				CPULimit:    s.config.Worker.CPULimit,
 				MemoryLimit: s.config.Worker.MemoryLimit,
 			},
+			Cache: &worker.CacheRequest{
+				Repository: req.Repository,
+				Key:        req.BuildConfig["cache_key"],
+			},
+			Owner: owner,
 		}
 	}
 
@@ -116,6 +122,8 @@ func (s *Server) convertWorkerStatusesToPB(ctx context.Context, taskID string) (
 			NodeName:  pod.Spec.NodeName,
 			PodIp:     pod.Status.PodIP,
 			Labels:    pod.Labels,
+			CacheHit:  pod.Annotations[worker.CacheHitAnnotation] == "true",
+			Steps:     s.workerManager.WorkerSteps(pod.Name),
 		}
 
 		// Set start time
@@ -161,23 +169,6 @@ func calculateStatusCounts(statuses []*pb.WorkerPodStatus) (running, pending, su
 	return
 }
 
-// sanitizeTaskID sanitizes task ID for use in pod names.
-//
-// sanitizeTaskID는 Task ID를 Pod 이름에 사용할 수 있도록 정리합니다.
-func sanitizeTaskID(taskID string) string {
-	// Replace invalid characters with hyphens
-	sanitized := strings.ReplaceAll(taskID, "_", "-")
-	sanitized = strings.ReplaceAll(sanitized, ".", "-")
-	sanitized = strings.ToLower(sanitized)
-
-	// Kubernetes pod names must be max 63 characters
-	if len(sanitized) > 50 {
-		sanitized = sanitized[:50]
-	}
-
-	return sanitized
-}
-
 // sanitizeLabel sanitizes label values for Kubernetes labels.
 //
 // sanitizeLabel은 Kubernetes 라벨에 사용할 수 있도록 값을 정리합니다.
@@ -14,11 +14,22 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 
+	"github.com/Team-5-CodeCat/ottoscaler/internal/auth"
 	"github.com/Team-5-CodeCat/ottoscaler/internal/config"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/health"
 	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
 	"github.com/Team-5-CodeCat/ottoscaler/internal/pipeline"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/search"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/spool"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/tlsconfig"
 	"github.com/Team-5-CodeCat/ottoscaler/internal/worker"
 	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
 )
@@ -34,6 +45,7 @@ type Server struct {
 	workerManager   *worker.Manager
 	k8sClient       *k8s.Client
 	logStreamServer *LogStreamingServer
+	logSpool        *spool.Spool // nil이면 스풀 없이 바로 전달
 	
 	// Pipeline 실행 관리
 	pipelineExecutors map[string]*pipeline.Executor
@@ -47,14 +59,17 @@ type Server struct {
 // Parameters:
 //   - cfg: 서버 설정
 //   - workerManager: Worker Pod 관리자
-//   - k8sClient: Kubernetes API 클라이언트
+//   - k8sClient: Kubernetes API 클라이언트 (local 백엔드에서는 nil 가능)
 //
 // Returns:
 //   - *Server: 초기화된 서버 인스턴스
 func NewServer(cfg *config.Config, workerManager *worker.Manager, k8sClient *k8s.Client) *Server {
-	if cfg == nil || workerManager == nil || k8sClient == nil {
+	if cfg == nil || workerManager == nil {
 		panic("NewServer: nil parameters are not allowed")
 	}
+	if k8sClient == nil && cfg.Worker.Backend != string(worker.BackendLocal) {
+		panic("NewServer: k8sClient is required unless the local worker backend is used")
+	}
 
 	log.Printf("🚀 Ottoscaler gRPC 서버 초기화 중 (포트: %d)", cfg.GRPC.Port)
 
@@ -68,17 +83,175 @@ func NewServer(cfg *config.Config, workerManager *worker.Manager, k8sClient *k8s
 		log.Printf("🔗 Otto-handler 연결 중: %s", ottoHandlerAddress)
 	}
 
-	logStreamServer := NewLogStreamingServer(k8sClient, ottoHandlerAddress, mockMode)
+	var ottoHandlerTLS *tlsconfig.ClientOptions
+	if clientTLS := cfg.GRPC.OttoHandlerTLS; clientTLS.Enabled {
+		ottoHandlerTLS = &tlsconfig.ClientOptions{
+			CertFile:   clientTLS.CertFile,
+			KeyFile:    clientTLS.KeyFile,
+			CAFile:     clientTLS.CAFile,
+			ServerName: clientTLS.ServerName,
+		}
+	}
+
+	logStreamServer := NewLogStreamingServer(k8sClient, ottoHandlerAddress, mockMode, ottoHandlerTLS)
+
+	// Configure base PodTemplate for worker pods
+	workerManager.SetPodTemplateSource(worker.PodTemplateSource{
+		File: cfg.Worker.PodTemplate.File,
+		Name: cfg.Worker.PodTemplate.Name,
+	})
+
+	// Configure per-repository dependency cache volumes
+	workerManager.SetCacheSettings(worker.CacheSettings{
+		Enabled:      cfg.Worker.Cache.Enabled,
+		StorageClass: cfg.Worker.Cache.StorageClass,
+		Size:         cfg.Worker.Cache.Size,
+		AccessMode:   cfg.Worker.Cache.AccessMode,
+		MaxVolumes:   cfg.Worker.Cache.MaxVolumes,
+		Paths:        cfg.Worker.Cache.Paths,
+	})
+
+	// Configure worker backend (bare pods or batch/v1 Jobs)
+	workerManager.SetBackend(worker.Backend(cfg.Worker.Backend), worker.JobSettings{
+		BackoffLimit:            cfg.Worker.Job.BackoffLimit,
+		ActiveDeadlineSeconds:   cfg.Worker.Job.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: cfg.Worker.Job.TTLSecondsAfterFinished,
+		FailJobExitCodes:        cfg.Worker.Job.FailJobExitCodes,
+	})
+
+	// Mint per-pod bootstrap tokens so RegisterWorker can tell real workers from spoofed ones
+	bootstrapTTL := time.Duration(cfg.Worker.Bootstrap.TTLSeconds) * time.Second
+	bootstrapSigner, err := auth.LoadBootstrapSigner(cfg.Worker.Bootstrap.KeyFile, bootstrapTTL)
+	if err != nil {
+		log.Printf("⚠️ 부트스트랩 키 파일을 읽지 못해 임의의 키를 사용합니다 (재시작 전 Worker의 토큰은 무효): %v", err)
+		bootstrapSigner, err = auth.NewBootstrapSigner(nil, bootstrapTTL)
+	}
+	if err != nil {
+		log.Printf("⚠️ 부트스트랩 토큰 비활성화: %v", err)
+	} else {
+		workerManager.SetBootstrapSigner(bootstrapSigner)
+		logStreamServer.SetWorkerVerifier(workerManager, cfg.Worker.Bootstrap.Required)
+	}
+
+	// Spool forwarded logs on disk so they survive Otto-handler outages and restarts
+	var logSpool *spool.Spool
+	var logForwarder worker.LogForwarder = logStreamServer.ottoHandlerClient
+	if cfg.Logging.Spool.Enabled {
+		opened, err := spool.Open(spool.Config{
+			Dir:      cfg.Logging.Spool.Dir,
+			MaxBytes: int64(cfg.Logging.Spool.MaxBytes),
+		})
+		if err != nil {
+			log.Printf("⚠️ 로그 스풀 초기화 실패, 스풀 없이 전달합니다: %v", err)
+		} else {
+			log.Printf("💾 로그 스풀 활성화 (dir: %s)", cfg.Logging.Spool.Dir)
+			logSpool = opened
+			logForwarder = logSpool
+			logStreamServer.SetSpool(logSpool)
+		}
+	}
+
+	// Forward logs collected from worker pods to Otto-handler
+	workerManager.SetLogForwarder(logForwarder, worker.LogForwardingSettings{
+		Enabled:    cfg.Logging.Forwarding,
+		BufferSize: cfg.Logging.BufferSize,
+	})
+
+	// Batch forwarded logs and enforce the limits handed out to workers
+	batchSize := cfg.Logging.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultLogBatchSize
+	}
+	logStreamServer.ottoHandlerClient.SetBatching(batchSize, time.Duration(cfg.Logging.BatchIntervalMs)*time.Millisecond)
+	logStreamServer.ottoHandlerClient.SetMaxInFlight(cfg.Logging.MaxInFlight)
+	logStreamServer.ottoHandlerClient.SetMultiplexing(cfg.Logging.MultiplexStreams)
+	logStreamServer.SetLoggingConfig(&pb.LoggingConfig{
+		RateLimit:       int32(cfg.Logging.RateLimit),
+		BufferSize:      int32(batchSize),
+		MaxMessageSize:  int32(cfg.Logging.MaxMessageSize),
+		IncludeMetadata: true,
+	})
+
+	// Render ANSI colors and split lines longer than max_message_size
+	ansiMode, err := logs.ParseANSIMode(cfg.Logging.ANSI)
+	if err != nil {
+		log.Printf("⚠️ %v, ANSI 코드를 그대로 둡니다", err)
+		ansiMode = logs.ANSIKeep
+	}
+	workerManager.SetLogContent(worker.LogContentSettings{
+		MaxMessageSize: cfg.Logging.MaxMessageSize,
+		ANSI:           ansiMode,
+	})
+
+	// Mask secrets before logs leave the cluster (forwarding, archive, TailLogs)
+	redaction := worker.RedactionSettings{Enabled: cfg.Logging.Redaction.Enabled}
+	if redaction.Enabled {
+		patterns, err := logs.CompileRedactionPatterns(cfg.Logging.Redaction.Patterns)
+		if err != nil {
+			log.Printf("⚠️ 로그 마스킹 패턴 오류, 기본 패턴을 사용합니다: %v", err)
+			patterns = logs.DefaultRedactionPatterns()
+		}
+		redaction.Patterns = patterns
+	}
+	workerManager.SetLogRedaction(redaction)
+	logStreamServer.SetRedaction(redaction)
+
+	// Persist every worker's full log so it can be served after pod deletion
+	logArchive, err := NewLogArchive(cfg.Logging.Archive)
+	if err != nil {
+		log.Printf("⚠️ 로그 아카이브 초기화 실패, 보관 없이 실행합니다: %v", err)
+	} else if logArchive != nil {
+		log.Printf("🗄️ Worker 로그 보관 활성화 (backend: %s)", cfg.Logging.Archive.Backend)
+		workerManager.SetLogArchive(logArchive)
+	}
+
+	// Index collected logs locally so SearchLogs does not rescan every file
+	if cfg.Logging.Search.Enabled {
+		retention := time.Duration(cfg.Logging.Search.RetentionHours) * time.Hour
+		if logIndex, err := search.Open(cfg.Logging.Search.Dir, retention); err != nil {
+			log.Printf("⚠️ 로그 검색 색인 초기화 실패, 색인 없이 실행합니다: %v", err)
+		} else {
+			log.Printf("🔎 로그 검색 색인 활성화 (dir: %s)", cfg.Logging.Search.Dir)
+			workerManager.SetLogIndex(logIndex)
+		}
+	}
 
 	return &Server{
 		config:            cfg,
 		workerManager:     workerManager,
 		k8sClient:         k8sClient,
 		logStreamServer:   logStreamServer,
+		logSpool:          logSpool,
 		pipelineExecutors: make(map[string]*pipeline.Executor),
 	}
 }
 
+// newHealthChecker registers the gRPC health service and the readiness checks.
+//
+// newHealthChecker는 grpc.health.v1 서비스를 등록하고 준비 상태 검사를 구성합니다.
+//   - kubernetes: API 서버 연결 (실패 시 OttoscalerService NOT_SERVING, k8sClient가 없으면 생략)
+//   - otto-handler: 로그 전달 연결 (실패 시 LogStreamingService NOT_SERVING)
+//
+// 공유 informer 캐시는 없으므로 (멀티 Pod 로그는 요청마다 List/Watch, Job 추적은 폴링)
+// 캐시 동기화 검사는 등록하지 않습니다.
+func (s *Server) newHealthChecker(grpcServer *grpc.Server) *health.Checker {
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	ottoscalerService := pb.OttoscalerService_ServiceDesc.ServiceName
+	logStreamingService := pb.LogStreamingService_ServiceDesc.ServiceName
+	checker := health.NewChecker(healthServer, ottoscalerService, logStreamingService)
+
+	if s.k8sClient != nil {
+		checker.AddCheck("kubernetes", s.k8sClient.Ping, ottoscalerService)
+	}
+	checker.AddCheck("otto-handler", func(context.Context) error {
+		return s.logStreamServer.ottoHandlerClient.CheckConnection()
+	}, logStreamingService)
+
+	return checker
+}
+
 // ScaleUp handles scale up requests from otto-handler.
 //
 // ScaleUp은 otto-handler로부터 스케일 업 요청을 처리합니다.
@@ -100,8 +273,21 @@ func (s *Server) ScaleUp(ctx context.Context, req *pb.ScaleRequest) (*pb.ScaleRe
 		return nil, status.Error(codes.InvalidArgument, "worker_count must be positive")
 	}
 
+	// Create or bump the task's parent object (owner of all its worker pods)
+	owner, err := s.workerManager.EnsureRunOwner(ctx, worker.OwnerKindTask, req.TaskId, map[string]string{
+		"repository":   req.Repository,
+		"commit-sha":   req.CommitSha,
+		"triggered-by": req.TriggeredBy,
+		"reason":       req.Reason,
+		"worker-count": fmt.Sprint(req.WorkerCount),
+	})
+	if err != nil {
+		log.Printf("❌ 태스크 %s의 부모 오브젝트 생성 실패: %v", req.TaskId, err)
+		return nil, status.Errorf(codes.Internal, "failed to prepare task %s: %v", req.TaskId, err)
+	}
+
 	// Create worker configurations
-	workerConfigs := s.createWorkerConfigs(req)
+	workerConfigs := s.createWorkerConfigs(req, owner)
 
 	// Extract worker names for response
 	workerPodNames := make([]string, len(workerConfigs))
@@ -136,6 +322,9 @@ func (s *Server) ScaleUp(ctx context.Context, req *pb.ScaleRequest) (*pb.ScaleRe
 //
 // ScaleDown은 otto-handler로부터 스케일 다운 요청을 처리합니다.
 // 지정된 Worker Pod들을 종료하고 결과를 반환합니다.
+//
+// worker_count가 0이면 Task 전체를 삭제합니다. Task의 부모 오브젝트를 삭제하면
+// ownerReference로 연결된 모든 Worker Pod가 함께 삭제됩니다.
 func (s *Server) ScaleDown(ctx context.Context, req *pb.ScaleRequest) (*pb.ScaleResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
@@ -150,7 +339,31 @@ func (s *Server) ScaleDown(ctx context.Context, req *pb.ScaleRequest) (*pb.Scale
 		return nil, status.Error(codes.InvalidArgument, "task_id is required")
 	}
 
-	// TODO: Implement actual worker termination logic
+	if req.WorkerCount == 0 {
+		podNames, err := s.workerManager.DeleteRunOwner(ctx, worker.OwnerKindTask, req.TaskId)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, status.Errorf(codes.NotFound, "task %s not found", req.TaskId)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to delete task %s: %v", req.TaskId, err)
+		}
+
+		response := &pb.ScaleResponse{
+			Status:         pb.ScaleResponse_SUCCESS,
+			Message:        fmt.Sprintf("Deleted task %s and %d worker pods", req.TaskId, len(podNames)),
+			ProcessedCount: int32(len(podNames)),
+			WorkerPodNames: podNames,
+			StartedAt:      startTime.Format(time.RFC3339),
+			CompletedAt:    time.Now().Format(time.RFC3339),
+		}
+
+		log.Printf("✅ ScaleDown 완료 (태스크 삭제): task_id=%s, 처리된 수=%d, 소요 시간=%v",
+			req.TaskId, response.ProcessedCount, time.Since(startTime))
+
+		return response, nil
+	}
+
+	// TODO: Implement partial worker termination logic
 	response := &pb.ScaleResponse{
 		Status:         pb.ScaleResponse_SUCCESS,
 		Message:        fmt.Sprintf("Successfully processed scale down request for task %s", req.TaskId),
@@ -219,6 +432,18 @@ func (s *Server) ExecutePipeline(req *pb.PipelineRequest, stream pb.OttoscalerSe
 	if len(req.Stages) == 0 {
 		return status.Error(codes.InvalidArgument, "at least one stage is required")
 	}
+	for _, stage := range req.Stages {
+		for _, service := range stage.Services {
+			if service.Name == "" || service.Image == "" {
+				return status.Errorf(codes.InvalidArgument,
+					"stage %s: service name and image are required", stage.StageId)
+			}
+			if errs := validation.IsDNS1123Label(worker.ServiceContainerPrefix + service.Name); len(errs) > 0 {
+				return status.Errorf(codes.InvalidArgument,
+					"stage %s: invalid service name %q: %s", stage.StageId, service.Name, errs[0])
+			}
+		}
+	}
 	
 	log.Printf("🚀 ExecutePipeline 요청 수신: pipeline_id=%s, name=%s, stages=%d",
 		req.PipelineId, req.Name, len(req.Stages))
@@ -277,15 +502,85 @@ func (s *Server) ExecutePipeline(req *pb.PipelineRequest, stream pb.OttoscalerSe
 func (s *Server) Start(ctx context.Context) error {
 	addr := s.config.GetGRPCAddr()
 
+	// TLS 인증서를 읽지 못하면 평문으로 대체하지 않고 시작을 중단
+	var serverOptions []grpc.ServerOption
+	if serverTLS := s.config.GRPC.TLS; serverTLS.Enabled {
+		creds, reloader, err := tlsconfig.ServerCredentials(tlsconfig.ServerOptions{
+			CertFile:   serverTLS.CertFile,
+			KeyFile:    serverTLS.KeyFile,
+			CAFile:     serverTLS.CAFile,
+			ClientAuth: serverTLS.ClientAuth,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to configure gRPC TLS: %w", err)
+		}
+		go reloader.Run(ctx, tlsconfig.DefaultReloadInterval)
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+		log.Printf("🔒 gRPC 서버 TLS 활성화 (client auth: %s)", serverTLS.ClientAuth)
+	}
+
+	// 인증 설정을 읽지 못하면 인증 없이 열지 않고 시작을 중단
+	if authConfig := s.config.GRPC.Auth; authConfig.Enabled {
+		roles := make(map[string]auth.Role, len(authConfig.Roles))
+		for name, role := range authConfig.Roles {
+			roles[name] = auth.Role{Methods: role.Methods, Repositories: role.Repositories}
+		}
+		authenticator, err := auth.New(auth.Options{
+			TokensFile:    authConfig.TokensFile,
+			JWTSecretFile: authConfig.JWT.SecretFile,
+			JWTIssuer:     authConfig.JWT.Issuer,
+			JWTAudience:   authConfig.JWT.Audience,
+			Roles:         roles,
+			TaskRepository: func(ctx context.Context, taskID string) (string, bool, error) {
+				data, err := s.workerManager.RunOwnerData(ctx, worker.OwnerKindTask, taskID)
+				if apierrors.IsNotFound(err) {
+					return "", false, nil
+				}
+				if err != nil {
+					return "", false, err
+				}
+				return data["repository"], true, nil
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to configure gRPC auth: %w", err)
+		}
+		go authenticator.Run(ctx, auth.DefaultReloadInterval)
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamInterceptor()),
+		)
+		log.Printf("🔑 gRPC 호출자 인증 활성화")
+	} else {
+		log.Printf("⚠️ gRPC 호출자 인증이 꺼져 있습니다 (AUTH_ENABLED=false): 포트에 접근할 수 있으면 누구나 호출할 수 있습니다")
+	}
+
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", addr, err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOptions...)
 	pb.RegisterOttoscalerServiceServer(grpcServer, s)
 	pb.RegisterLogStreamingServiceServer(grpcServer, s.logStreamServer)
 
+	// grpc.health.v1 서비스별 상태와 HTTP /healthz, /readyz
+	checker := s.newHealthChecker(grpcServer)
+	go checker.Run(ctx, time.Duration(s.config.Health.CheckIntervalSeconds)*time.Second)
+	if healthPort := s.config.Health.Port; healthPort > 0 {
+		go func() {
+			if err := health.Serve(ctx, fmt.Sprintf(":%d", healthPort), checker.Handler()); err != nil {
+				log.Printf("❌ 헬스 체크 서버 오류: %v", err)
+			}
+		}()
+		log.Printf("💓 헬스 체크 서버 시작 (포트: %d, /healthz, /readyz)", healthPort)
+	}
+
+	if s.config.GRPC.Reflection {
+		reflection.Register(grpcServer)
+		log.Printf("🪞 gRPC 서버 리플렉션 활성화")
+	}
+
 	log.Printf("🎯 gRPC 서버 시작 (주소: %s)", addr)
 
 	// Start periodic cleanup for log streaming server
@@ -296,6 +591,7 @@ func (s *Server) Start(ctx context.Context) error {
 		for {
 			select {
 			case <-cleanupTicker.C:
+				s.logStreamServer.LogSessionStats()
 				s.logStreamServer.CleanupInactiveSessions()
 			case <-ctx.Done():
 				return
@@ -303,6 +599,11 @@ func (s *Server) Start(ctx context.Context) error {
 		}
 	}()
 
+	// Replay spooled logs to Otto-handler
+	if s.logSpool != nil {
+		go s.logSpool.Run(ctx, s.logStreamServer.ottoHandlerClient)
+	}
+
 	// Start server in goroutine
 	errChan := make(chan error, 1)
 	go func() {
@@ -315,6 +616,7 @@ func (s *Server) Start(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
 		log.Println("🛑 Shutting down gRPC server...")
+		checker.Shutdown()
 
 		// Stop all active log collections
 		log.Printf("📜 활성 로그 스트리밍 세션: %d개", s.logStreamServer.GetActiveSessionsCount())
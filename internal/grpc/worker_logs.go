@@ -0,0 +1,414 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/archive"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/config"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/worker"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// defaultLogLineLimit은 limit이 지정되지 않았을 때 Worker당 반환할 최대 라인 수입니다
+	defaultLogLineLimit = 5000
+	// maxLogLineLimit은 Worker당 반환할 수 있는 최대 라인 수입니다
+	maxLogLineLimit = 20000
+	// maxLogResponseLines는 응답 하나에 담을 수 있는 전체 Worker의 최대 라인 수입니다
+	maxLogResponseLines = 20000
+	// maxLogResponseBytes는 응답 하나에 담을 로그 내용의 최대 크기입니다 (gRPC 기본 4MiB 한도 이하)
+	maxLogResponseBytes = 3 * 1024 * 1024
+)
+
+// NewLogArchive creates the worker log archive described by the configuration.
+//
+// NewLogArchive는 설정에 따라 Worker 로그 아카이브를 생성합니다.
+// 보관이 비활성화되어 있으면 nil을 반환합니다.
+func NewLogArchive(cfg config.LogArchiveConfig) (*archive.Archive, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Backend {
+	case "", "local":
+		store, err := archive.NewLocalStore(cfg.Dir)
+		if err != nil {
+			return nil, err
+		}
+		return archive.New(store), nil
+	case "s3":
+		store, err := archive.NewS3Store(archive.S3Config{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			Prefix:    cfg.S3.Prefix,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return archive.New(store), nil
+	default:
+		return nil, fmt.Errorf("unsupported log archive backend %q", cfg.Backend)
+	}
+}
+
+// GetWorkerLogs serves archived worker logs, even after the worker pods are deleted.
+//
+// GetWorkerLogs는 보관된 Worker 로그를 반환합니다.
+// Worker Pod가 이미 삭제되었어도 아카이브에 남은 로그를 조회할 수 있습니다.
+func (s *Server) GetWorkerLogs(ctx context.Context, req *pb.GetWorkerLogsRequest) (*pb.GetWorkerLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	logArchive := s.workerManager.LogArchive()
+	if logArchive == nil {
+		return nil, status.Error(codes.FailedPrecondition, "worker log archive is not enabled")
+	}
+
+	log.Printf("🗄️ GetWorkerLogs 요청 수신: worker_id=%s, task_id=%s, pipeline_id=%s, stage_id=%s",
+		req.GetWorkerId(), req.GetTaskId(), req.GetPipelineId(), req.StageId)
+
+	filter, err := newLogRangeFilter(req.Range)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cursorWorker, cursorOffset, err := decodeLogPageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 조회 대상 Worker 목록 결정
+	var workerIDs []string
+	switch target := req.Target.(type) {
+	case *pb.GetWorkerLogsRequest_WorkerId:
+		if target.WorkerId == "" {
+			return nil, status.Error(codes.InvalidArgument, "worker_id cannot be empty")
+		}
+		// Job Worker는 설정 이름으로도 조회 가능
+		workerIDs = []string{s.workerManager.PodName(target.WorkerId)}
+	case *pb.GetWorkerLogsRequest_TaskId:
+		if target.TaskId == "" {
+			return nil, status.Error(codes.InvalidArgument, "task_id cannot be empty")
+		}
+		workerIDs, err = logArchive.WorkersForTask(ctx, target.TaskId)
+	case *pb.GetWorkerLogsRequest_PipelineId:
+		if target.PipelineId == "" {
+			return nil, status.Error(codes.InvalidArgument, "pipeline_id cannot be empty")
+		}
+		workerIDs, err = logArchive.WorkersForPipeline(ctx, target.PipelineId, req.StageId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "one of worker_id, task_id or pipeline_id is required")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up archived logs: %v", err)
+	}
+	if len(workerIDs) == 0 {
+		return nil, status.Error(codes.NotFound, "no archived logs found")
+	}
+	sort.Strings(workerIDs)
+
+	response := &pb.GetWorkerLogsResponse{}
+	budget := &logBudget{lines: maxLogResponseLines, bytes: maxLogResponseBytes}
+	for _, workerID := range workerIDs {
+		// 이전 페이지에서 이미 반환한 Worker는 건너뜀
+		if workerID < cursorWorker {
+			continue
+		}
+		workerFilter := filter
+		if workerID == cursorWorker {
+			workerFilter.offset = cursorOffset
+		}
+		if budget.full() {
+			response.HasMore = true
+			response.NextPageToken = encodeLogPageToken(workerID, workerFilter.offset)
+			break
+		}
+
+		workerLogs, err := readArchivedLogs(ctx, logArchive, workerID, workerFilter, req.Format, budget)
+		if errors.Is(err, archive.ErrNotFound) {
+			if len(workerIDs) == 1 {
+				return nil, status.Errorf(codes.NotFound, "no archived logs for worker %s", workerID)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to read archived logs of %s: %v", workerID, err)
+		}
+		if workerLogs.LineCount > 0 || !budget.stopped {
+			response.Workers = append(response.Workers, workerLogs)
+		}
+
+		// 응답 한도 때문에 Worker 중간에서 멈췄으면 같은 Worker의 다음 라인부터 이어서 조회
+		if budget.stopped {
+			response.HasMore = true
+			response.NextPageToken = encodeLogPageToken(workerID, workerLogs.Offset+int64(workerLogs.LineCount))
+			break
+		}
+	}
+
+	log.Printf("✅ GetWorkerLogs 완료: Worker %d개, has_more=%v", len(response.Workers), response.HasMore)
+	return response, nil
+}
+
+// logRangeFilter는 LogRange를 검증한 결과입니다
+type logRangeFilter struct {
+	offset int64
+	limit  int
+	since  time.Time
+	until  time.Time
+}
+
+// newLogRangeFilter는 LogRange를 검증하고 기본값을 채웁니다
+func newLogRangeFilter(r *pb.LogRange) (logRangeFilter, error) {
+	filter := logRangeFilter{limit: defaultLogLineLimit}
+	if r == nil {
+		return filter, nil
+	}
+
+	if r.Offset < 0 || r.Limit < 0 {
+		return filter, fmt.Errorf("range offset and limit must not be negative")
+	}
+	filter.offset = r.Offset
+	if r.Limit > 0 {
+		filter.limit = int(r.Limit)
+	}
+	if filter.limit > maxLogLineLimit {
+		filter.limit = maxLogLineLimit
+	}
+
+	var err error
+	if r.Since != "" {
+		if filter.since, err = time.Parse(time.RFC3339, r.Since); err != nil {
+			return filter, fmt.Errorf("invalid range.since: %w", err)
+		}
+	}
+	if r.Until != "" {
+		if filter.until, err = time.Parse(time.RFC3339, r.Until); err != nil {
+			return filter, fmt.Errorf("invalid range.until: %w", err)
+		}
+	}
+	return filter, nil
+}
+
+// matches는 엔트리가 시간 범위에 포함되는지 확인합니다
+func (f logRangeFilter) matches(entry archive.Entry) bool {
+	if !f.since.IsZero() && entry.Timestamp.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && entry.Timestamp.After(f.until) {
+		return false
+	}
+	return true
+}
+
+// logBudget은 GetWorkerLogs 응답 하나에 더 담을 수 있는 라인 수와 바이트 수입니다
+type logBudget struct {
+	lines   int
+	bytes   int
+	used    bool // 라인을 하나라도 담았는지 여부
+	stopped bool // 한도에 도달해 Worker를 읽다가 멈췄는지 여부
+}
+
+// full은 한도를 모두 사용했는지 확인합니다
+func (b *logBudget) full() bool {
+	return b.lines <= 0 || b.bytes <= 0
+}
+
+// take는 n바이트 라인 하나를 담을 수 있으면 한도에서 빼고 true를 반환합니다.
+// 응답이 비어있으면 한도보다 긴 라인도 하나는 담아 페이지가 항상 진행되도록 합니다.
+func (b *logBudget) take(n int) bool {
+	if b.used && (b.lines <= 0 || n > b.bytes) {
+		b.stopped = true
+		return false
+	}
+	b.used = true
+	b.lines--
+	b.bytes -= n
+	return true
+}
+
+// encodeLogPageToken은 다음 페이지를 시작할 Worker와 offset을 토큰으로 인코딩합니다
+func encodeLogPageToken(workerID string, offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(workerID + "\x00" + strconv.FormatInt(offset, 10)))
+}
+
+// decodeLogPageToken은 페이지 토큰에서 Worker와 offset을 복원합니다 (빈 토큰은 처음부터)
+func decodeLogPageToken(token string) (string, int64, error) {
+	if token == "" {
+		return "", 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, fmt.Errorf("malformed page token")
+	}
+	workerID, offsetText, ok := strings.Cut(string(raw), "\x00")
+	if !ok || workerID == "" {
+		return "", 0, fmt.Errorf("malformed page token")
+	}
+	offset, err := strconv.ParseInt(offsetText, 10, 64)
+	if err != nil || offset < 0 {
+		return "", 0, fmt.Errorf("malformed page token")
+	}
+	return workerID, offset, nil
+}
+
+// readArchivedLogs는 Worker 하나의 보관된 로그를 범위와 형식에 맞게 읽습니다.
+// offset과 limit은 시간 범위에 포함되는 라인을 기준으로 적용되며,
+// 응답 한도(budget)에 도달하면 그 라인 앞에서 멈춥니다.
+func readArchivedLogs(ctx context.Context, logArchive *archive.Archive, workerID string, filter logRangeFilter, format pb.LogFormat, budget *logBudget) (*pb.ArchivedWorkerLogs, error) {
+	manifest, err := logArchive.Manifest(ctx, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ArchivedWorkerLogs{
+		WorkerId:   manifest.WorkerID,
+		TaskId:     manifest.TaskID,
+		PipelineId: manifest.PipelineID,
+		StageId:    manifest.StageID,
+		TotalLines: manifest.Lines,
+		Offset:     filter.offset,
+		ArchivedAt: manifest.ArchivedAt.Format(time.RFC3339),
+	}
+
+	var content, line strings.Builder
+	var matched int64
+	var encodeErr error
+
+	err = logArchive.ReadEntries(ctx, workerID, func(_ int64, entry archive.Entry) bool {
+		if !filter.matches(entry) {
+			return true
+		}
+		matched++
+		if matched <= filter.offset {
+			return true
+		}
+		if int(result.LineCount) >= filter.limit {
+			result.HasMore = true
+			return false
+		}
+
+		line.Reset()
+		if encodeErr = writeLogLine(&line, entry, format); encodeErr != nil {
+			return false
+		}
+		if !budget.take(line.Len()) {
+			result.HasMore = true
+			return false
+		}
+		content.WriteString(line.String())
+		result.LineCount++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+
+	result.Content = content.String()
+	return result, nil
+}
+
+// writeLogLine은 엔트리 하나를 요청한 형식으로 씁니다
+func writeLogLine(b *strings.Builder, entry archive.Entry, format pb.LogFormat) error {
+	switch format {
+	case pb.LogFormat_LOG_FORMAT_JSON:
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	default:
+		fmt.Fprintf(b, "%s [%s] %s\n", entry.Timestamp.Format(time.RFC3339Nano), entry.Level, entry.Message)
+	}
+	return nil
+}
+
+// TailLogs streams worker logs for a worker, task or pipeline scope.
+//
+// TailLogs는 Worker, 작업, Pipeline 범위의 로그를 스트리밍합니다.
+// 아카이브에 보관된 로그로 먼저 채운 뒤 실행 중인 Worker의 로그를 이어서 전달합니다.
+func (s *Server) TailLogs(req *pb.TailLogsRequest, stream pb.OttoscalerService_TailLogsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	tailReq := worker.TailRequest{
+		StageID:   req.StageId,
+		Follow:    req.Follow,
+		TailLines: req.TailLines,
+	}
+	switch target := req.Target.(type) {
+	case *pb.TailLogsRequest_WorkerId:
+		tailReq.WorkerID = target.WorkerId
+	case *pb.TailLogsRequest_TaskId:
+		tailReq.TaskID = target.TaskId
+	case *pb.TailLogsRequest_PipelineId:
+		tailReq.PipelineID = target.PipelineId
+	default:
+		return status.Error(codes.InvalidArgument, "one of worker_id, task_id or pipeline_id is required")
+	}
+	if tailReq.WorkerID == "" && tailReq.TaskID == "" && tailReq.PipelineID == "" {
+		return status.Error(codes.InvalidArgument, "target id cannot be empty")
+	}
+	if req.TailLines < 0 {
+		return status.Error(codes.InvalidArgument, "tail_lines must not be negative")
+	}
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+		tailReq.Since = since
+	}
+	if req.MinLevel != "" {
+		tailReq.MinLevel = logs.NormalizeLevel(req.MinLevel)
+		if tailReq.MinLevel == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid min_level %q", req.MinLevel)
+		}
+	}
+
+	log.Printf("📡 TailLogs 요청 수신: worker_id=%s, task_id=%s, pipeline_id=%s, stage_id=%s, follow=%v",
+		req.GetWorkerId(), req.GetTaskId(), req.GetPipelineId(), req.StageId, req.Follow)
+
+	ctx := stream.Context()
+	entries, errChan := s.workerManager.TailLogs(ctx, tailReq)
+
+	var sent int
+	for entry := range entries {
+		if err := stream.Send(entry); err != nil {
+			log.Printf("❌ TailLogs 전송 실패: %v", err)
+			return err
+		}
+		sent++
+	}
+
+	if err := <-errChan; err != nil {
+		if errors.Is(err, worker.ErrNoWorkerLogs) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Errorf(codes.Internal, "failed to tail logs: %v", err)
+	}
+
+	log.Printf("✅ TailLogs 종료: %d개 엔트리 전송", sent)
+	return nil
+}
@@ -0,0 +1,279 @@
+// Package health tracks readiness checks and reports them over gRPC health and HTTP.
+//
+// health 패키지는 준비 상태 검사(Kubernetes API, Otto-handler 연결 등)를 주기적으로 실행하고,
+// 결과를 grpc.health.v1 서비스별 상태와 HTTP /healthz, /readyz로 제공합니다.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// DefaultCheckInterval은 준비 상태 검사 기본 간격입니다
+	DefaultCheckInterval = 5 * time.Second
+	// checkTimeout은 검사 하나의 최대 실행 시간입니다
+	checkTimeout = 3 * time.Second
+)
+
+// errNotChecked는 아직 한 번도 실행되지 않은 검사의 결과입니다
+var errNotChecked = errors.New("not checked yet")
+
+// CheckFunc reports whether a dependency is ready. A nil error means ready.
+//
+// CheckFunc는 의존 대상의 준비 상태를 확인합니다 (nil이면 준비됨).
+type CheckFunc func(ctx context.Context) error
+
+// check는 등록된 검사입니다
+type check struct {
+	name     string
+	fn       CheckFunc
+	services []string // 실패하면 NOT_SERVING이 되는 gRPC 서비스 (비어있으면 전체)
+}
+
+// Checker runs readiness checks and publishes their results.
+//
+// Checker는 등록된 검사를 주기적으로 실행하고 결과를 gRPC Health 서버에 반영합니다.
+// 전체 상태("")는 모든 검사가 통과해야 SERVING이며, 서비스별 상태는 그 서비스에 연결된 검사만 봅니다.
+type Checker struct {
+	grpcHealth *grpchealth.Server
+	services   []string
+
+	mu       sync.RWMutex
+	checks   []check
+	results  map[string]error
+	shutdown bool // Shutdown 이후에는 검사 결과를 갱신하지 않음
+}
+
+// NewChecker creates a checker that publishes to grpcHealth for services.
+//
+// NewChecker는 grpcHealth에 services의 상태를 게시하는 Checker를 생성합니다.
+// 첫 검사가 끝나기 전까지 모든 서비스는 NOT_SERVING입니다.
+func NewChecker(grpcHealth *grpchealth.Server, services ...string) *Checker {
+	c := &Checker{
+		grpcHealth: grpcHealth,
+		services:   services,
+		results:    make(map[string]error),
+	}
+	c.grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, service := range services {
+		c.grpcHealth.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return c
+}
+
+// AddCheck registers a readiness check. When it fails, the listed gRPC
+// services (all services if none are listed) report NOT_SERVING.
+//
+// AddCheck는 준비 상태 검사를 등록합니다.
+// 실패하면 services(비어있으면 모든 서비스)가 NOT_SERVING이 됩니다.
+func (c *Checker) AddCheck(name string, fn CheckFunc, services ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, fn: fn, services: services})
+	c.results[name] = errNotChecked
+}
+
+// Run runs the checks immediately and then every interval until ctx is done.
+//
+// Run은 검사를 즉시 한 번, 이후 interval마다 실행합니다. ctx가 취소되면 끝납니다.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.runChecks(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks every service NOT_SERVING so clients stop sending new requests.
+//
+// Shutdown은 종료 중임을 알리기 위해 모든 서비스를 NOT_SERVING으로 바꿉니다.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.shutdown = true
+	for _, chk := range c.checks {
+		c.results[chk.name] = errors.New("shutting down")
+	}
+	c.mu.Unlock()
+	c.grpcHealth.Shutdown()
+}
+
+// Results returns the latest result of every check (nil for passing checks).
+//
+// Results는 검사별 최근 결과를 반환합니다 (통과한 검사는 nil).
+func (c *Checker) Results() map[string]error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	results := make(map[string]error, len(c.results))
+	for name, err := range c.results {
+		results[name] = err
+	}
+	return results
+}
+
+// runChecks는 모든 검사를 병렬로 실행하고 결과를 gRPC Health 서버에 반영합니다
+func (c *Checker) runChecks(ctx context.Context) {
+	c.mu.RLock()
+	checks := append([]check(nil), c.checks...)
+	c.mu.RUnlock()
+
+	results := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, chk := range checks {
+		wg.Add(1)
+		go func(i int, chk check) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			results[i] = chk.fn(checkCtx)
+		}(i, chk)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	if c.shutdown || ctx.Err() != nil {
+		c.mu.Unlock()
+		return
+	}
+	for i, chk := range checks {
+		previous := c.results[chk.name]
+		c.results[chk.name] = results[i]
+		switch {
+		case results[i] != nil && (previous == nil || previous == errNotChecked):
+			log.Printf("⚠️ 준비 상태 검사 실패: %s: %v", chk.name, results[i])
+		case results[i] == nil && previous != nil && previous != errNotChecked:
+			log.Printf("✅ 준비 상태 검사 복구: %s", chk.name)
+		}
+	}
+	c.mu.Unlock()
+
+	// 서비스별 상태: 전체("")는 모든 검사, 각 서비스는 자신에게 연결된 검사
+	overall := healthpb.HealthCheckResponse_SERVING
+	serving := make(map[string]healthpb.HealthCheckResponse_ServingStatus, len(c.services))
+	for _, service := range c.services {
+		serving[service] = healthpb.HealthCheckResponse_SERVING
+	}
+	for i, chk := range checks {
+		if results[i] == nil {
+			continue
+		}
+		overall = healthpb.HealthCheckResponse_NOT_SERVING
+		affected := chk.services
+		if len(affected) == 0 {
+			affected = c.services
+		}
+		for _, service := range affected {
+			serving[service] = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	c.grpcHealth.SetServingStatus("", overall)
+	for service, status := range serving {
+		c.grpcHealth.SetServingStatus(service, status)
+	}
+}
+
+// Handler returns an HTTP handler serving /healthz and /readyz.
+//
+// Handler는 /healthz(프로세스 생존)와 /readyz(최근 검사 결과)를 제공하는 HTTP 핸들러를 반환합니다.
+//   - /readyz?verbose: 통과한 검사도 모두 표시
+//   - /readyz?exclude=<검사 이름>: 해당 검사를 제외 (여러 번 지정 가능)
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/readyz", c.serveReadyz)
+	return mux
+}
+
+// serveReadyz는 최근 검사 결과로 준비 상태를 응답합니다 (실패하면 503)
+func (c *Checker) serveReadyz(w http.ResponseWriter, r *http.Request) {
+	excluded := make(map[string]bool)
+	for _, name := range r.URL.Query()["exclude"] {
+		excluded[name] = true
+	}
+	_, verbose := r.URL.Query()["verbose"]
+
+	results := c.Results()
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body strings.Builder
+	ready := true
+	for _, name := range names {
+		switch {
+		case excluded[name]:
+			fmt.Fprintf(&body, "[+]%s excluded: ok\n", name)
+		case results[name] != nil:
+			ready = false
+			fmt.Fprintf(&body, "[-]%s failed: %v\n", name, results[name])
+		default:
+			fmt.Fprintf(&body, "[+]%s ok\n", name)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, body.String())
+		fmt.Fprintln(w, "readyz check failed")
+		return
+	}
+	if verbose {
+		fmt.Fprint(w, body.String())
+		fmt.Fprintln(w, "readyz check passed")
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// Serve runs an HTTP server for handler on addr until ctx is done.
+//
+// Serve는 addr에서 HTTP 헬스 체크 서버를 실행하고 ctx가 취소되면 종료합니다.
+func Serve(ctx context.Context, addr string, handler http.Handler) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err := <-errChan:
+		return fmt.Errorf("health server failed: %w", err)
+	}
+}
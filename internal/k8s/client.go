@@ -2,16 +2,19 @@
 package k8s
 
 import (
-	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -97,6 +100,14 @@ func getKubeconfigPath() string {
 	return ""
 }
 
+// Ping은 Kubernetes API 서버에 연결할 수 있는지 확인합니다 (/version 조회)
+func (c *Client) Ping(ctx context.Context) error {
+	if err := c.clientset.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error(); err != nil {
+		return fmt.Errorf("kubernetes API is unreachable: %w", err)
+	}
+	return nil
+}
+
 // CreatePod는 새로운 Pod를 생성합니다
 func (c *Client) CreatePod(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
 	createdPod, err := c.clientset.CoreV1().Pods(c.namespace).Create(ctx, pod, metav1.CreateOptions{})
@@ -143,6 +154,161 @@ func (c *Client) ListPods(ctx context.Context, labelSelector string) (*v1.PodLis
 	return pods, nil
 }
 
+// GetPodTemplate는 PodTemplate 오브젝트를 조회합니다
+func (c *Client) GetPodTemplate(ctx context.Context, name string) (*v1.PodTemplate, error) {
+	template, err := c.clientset.CoreV1().PodTemplates(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod template %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// CreatePVC는 새로운 PersistentVolumeClaim을 생성합니다
+func (c *Client) CreatePVC(ctx context.Context, pvc *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	created, err := c.clientset.CoreV1().PersistentVolumeClaims(c.namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pvc %s: %w", pvc.Name, err)
+	}
+
+	log.Printf("💾 PVC 생성 완료: %s", created.Name)
+	return created, nil
+}
+
+// GetPVC는 PersistentVolumeClaim 정보를 조회합니다
+func (c *Client) GetPVC(ctx context.Context, name string) (*v1.PersistentVolumeClaim, error) {
+	pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pvc %s: %w", name, err)
+	}
+	return pvc, nil
+}
+
+// ListPVCs는 라벨 셀렉터에 맞는 PersistentVolumeClaim 목록을 조회합니다
+func (c *Client) ListPVCs(ctx context.Context, labelSelector string) (*v1.PersistentVolumeClaimList, error) {
+	pvcs, err := c.clientset.CoreV1().PersistentVolumeClaims(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pvcs with selector '%s': %w", labelSelector, err)
+	}
+	return pvcs, nil
+}
+
+// AnnotatePVC는 PersistentVolumeClaim의 어노테이션을 갱신합니다 (merge patch)
+func (c *Client) AnnotatePVC(ctx context.Context, name string, annotations map[string]string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode pvc patch: %w", err)
+	}
+
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(c.namespace).Patch(ctx, name,
+		types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to annotate pvc %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeletePVC는 PersistentVolumeClaim을 삭제합니다
+func (c *Client) DeletePVC(ctx context.Context, name string) error {
+	err := c.clientset.CoreV1().PersistentVolumeClaims(c.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to delete pvc %s: %w", name, err)
+	}
+
+	log.Printf("🗑️ PVC 삭제 완료: %s", name)
+	return nil
+}
+
+// CreateJob은 새로운 batch/v1 Job을 생성합니다
+func (c *Client) CreateJob(ctx context.Context, job *batchv1.Job) (*batchv1.Job, error) {
+	created, err := c.clientset.BatchV1().Jobs(c.namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job %s: %w", job.Name, err)
+	}
+
+	log.Printf("🆕 Job 생성 완료: %s", created.Name)
+	return created, nil
+}
+
+// GetJob은 Job 정보를 조회합니다
+func (c *Client) GetJob(ctx context.Context, name string) (*batchv1.Job, error) {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s: %w", name, err)
+	}
+	return job, nil
+}
+
+// DeleteJob은 Job과 Job이 생성한 Pod들을 삭제합니다
+func (c *Client) DeleteJob(ctx context.Context, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	err := c.clientset.BatchV1().Jobs(c.namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete job %s: %w", name, err)
+	}
+
+	log.Printf("🗑️ Job 삭제 완료: %s", name)
+	return nil
+}
+
+// CreateConfigMap은 새로운 ConfigMap을 생성합니다
+func (c *Client) CreateConfigMap(ctx context.Context, configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	created, err := c.clientset.CoreV1().ConfigMaps(c.namespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create configmap %s: %w", configMap.Name, err)
+	}
+	return created, nil
+}
+
+// GetConfigMap은 ConfigMap 정보를 조회합니다
+func (c *Client) GetConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
+	configMap, err := c.clientset.CoreV1().ConfigMaps(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s: %w", name, err)
+	}
+	return configMap, nil
+}
+
+// GetSecret은 Secret을 조회합니다 (로그 마스킹에 사용할 값 확인용)
+func (c *Client) GetSecret(ctx context.Context, name string) (*v1.Secret, error) {
+	secret, err := c.clientset.CoreV1().Secrets(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+	return secret, nil
+}
+
+// UpdateConfigMap은 ConfigMap을 갱신합니다 (resourceVersion 충돌 시 Conflict 에러 반환)
+func (c *Client) UpdateConfigMap(ctx context.Context, configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	updated, err := c.clientset.CoreV1().ConfigMaps(c.namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update configmap %s: %w", configMap.Name, err)
+	}
+	return updated, nil
+}
+
+// DeleteConfigMap은 ConfigMap을 삭제합니다.
+//
+// Background 전파 정책을 사용하므로 ownerReference로 연결된
+// 하위 리소스(Worker Pod 등)는 가비지 컬렉터가 정리합니다.
+func (c *Client) DeleteConfigMap(ctx context.Context, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	err := c.clientset.CoreV1().ConfigMaps(c.namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete configmap %s: %w", name, err)
+	}
+
+	log.Printf("🗑️ ConfigMap 삭제 완료: %s", name)
+	return nil
+}
+
 // WatchPod는 특정 Pod의 상태 변화를 모니터링합니다.
 //
 // Pod가 완료(Succeeded) 또는 실패(Failed) 상태가 될 때까지
@@ -184,7 +350,10 @@ type LogEntry struct {
 	Container string    `json:"container"`
 	Timestamp time.Time `json:"timestamp"`
 	Message   string    `json:"message"`
-	Source    string    `json:"source"` // "stdout" or "stderr"
+	Source    string    `json:"source"`              // "stdout" or "stderr"
+	Marker    string    `json:"marker,omitempty"`    // 로그 라인이 아닌 표시 엔트리 (예: LogMarkerGap)
+	Part      int       `json:"part,omitempty"`      // MaxLogLineBytes를 넘는 라인의 조각 순번 (1부터, 0이면 한 라인 전체)
+	Continued bool      `json:"continued,omitempty"` // 같은 라인의 다음 조각이 이어짐
 }
 
 // LogStreamOptions contains options for log streaming
@@ -197,6 +366,9 @@ type LogStreamOptions struct {
 	Container  string // 특정 컨테이너 지정
 	Timestamps bool   // 타임스탬프 포함 여부
 	Previous   bool   // 이전 컨테이너 로그 포함
+
+	// StreamMultiplePodLogs 전용: Follow 중 발견한 모든 Pod가 종료되면 채널을 닫음
+	UntilAllFinished bool
 }
 
 // StreamPodLogs는 Pod의 로그를 실시간으로 스트리밍합니다.
@@ -204,6 +376,13 @@ type LogStreamOptions struct {
 // StreamPodLogs는 지정된 Pod의 stdout/stderr 로그를 실시간으로 수집하여
 // 채널로 전송합니다. 로그가 발생할 때마다 LogEntry로 파싱하여 전달합니다.
 //
+// API 서버 연결이 끊기면 마지막으로 전달한 라인의 타임스탬프를 SinceTime으로
+// 지정하여 자동으로 재연결하고, 이미 전달한 라인은 타임스탬프와 내용 해시로 걸러냅니다.
+// Follow 중 컨테이너가 재시작되면 이전 컨테이너의 남은 로그(Previous)를 먼저 읽고
+// 새 컨테이너로 이어가며, 읽지 못한 구간은 Marker가 LogMarkerGap인 엔트리로 알립니다.
+// 처음부터(TailLines, SinceTime 없이) 읽을 때 컨테이너가 이미 재시작된 상태면
+// 이전 컨테이너의 로그를 먼저 전달합니다.
+//
 // Context 취소 시 스트리밍이 중단되고 채널이 닫힙니다.
 func (c *Client) StreamPodLogs(ctx context.Context, podName string, options LogStreamOptions) (<-chan LogEntry, <-chan error) {
 	logChan := make(chan LogEntry, 100)
@@ -213,10 +392,10 @@ func (c *Client) StreamPodLogs(ctx context.Context, podName string, options LogS
 		defer close(logChan)
 		defer close(errChan)
 
-		// 기본 옵션 설정
+		// 기본 옵션 설정 (재연결 위치를 알기 위해 타임스탬프는 항상 요청)
 		podLogOpts := &v1.PodLogOptions{
 			Follow:     options.Follow,
-			Timestamps: options.Timestamps,
+			Timestamps: true,
 		}
 
 		if options.TailLines != nil {
@@ -235,60 +414,163 @@ func (c *Client) StreamPodLogs(ctx context.Context, podName string, options LogS
 			podLogOpts.Previous = options.Previous
 		}
 
-		// 로그 스트림 요청
-		logRequest := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, podLogOpts)
-		stream, err := logRequest.Stream(ctx)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to get log stream for pod %s: %w", podName, err)
-			return
+		resume := newLogResume()
+		trackRestarts := options.Follow && !options.Previous
+		if trackRestarts {
+			if pod, err := c.GetPod(ctx, podName); err == nil {
+				if status := findContainerStatus(pod, options.Container); status != nil {
+					resume.restarts = status.RestartCount
+				}
+			}
 		}
-		defer stream.Close()
 
 		log.Printf("📜 Pod 로그 스트리밍 시작: %s", podName)
 
-		// 로그 라인별로 파싱
-		scanner := bufio.NewScanner(stream)
-		for scanner.Scan() {
-			line := scanner.Text()
-			if line == "" {
-				continue
+		// 처음부터 읽을 때 이미 재시작된 컨테이너면 이전 인스턴스의 로그부터 전달
+		if trackRestarts && options.SinceTime == nil && options.TailLines == nil {
+			c.readPreviousLogs(ctx, podName, options.Container, resume, logChan)
+		}
+
+		failures := 0
+		for {
+			delivered := resume.delivered
+			err := c.streamPodLogsOnce(ctx, podName, podLogOpts, resume, logChan)
+			if ctx.Err() != nil {
+				log.Printf("📜 Pod 로그 스트리밍 취소됨: %s", podName)
+				return
+			}
+			if err == nil && !options.Follow {
+				log.Printf("📜 Pod 로그 스트리밍 완료: %s", podName)
+				return
 			}
 
-			logEntry := LogEntry{
-				PodName:   podName,
-				Container: options.Container,
-				Timestamp: time.Now(),
-				Message:   line,
-				Source:    "stdout", // Kubernetes logs API는 stdout/stderr 구분이 어려움
+			if trackRestarts {
+				finished, checkErr := c.checkLogResume(ctx, podName, options.Container, resume, logChan)
+				if finished {
+					log.Printf("📜 Pod 로그 스트리밍 완료: %s", podName)
+					return
+				}
+				if err == nil {
+					err = checkErr
+				}
 			}
 
-			// 타임스탬프가 포함된 경우 파싱
-			if options.Timestamps {
-				if parsed, msg := c.parseTimestampedLog(line); parsed != nil {
-					logEntry.Timestamp = *parsed
-					logEntry.Message = msg
+			// 새 라인 없이 끊기거나 실패가 반복되면 재연결 간격을 늘림
+			if err == nil && resume.delivered > delivered {
+				failures = 0
+			} else {
+				failures++
+			}
+			if failures > maxLogReconnects {
+				if err == nil {
+					err = fmt.Errorf("log stream keeps closing without new lines")
 				}
+				resume.sendGap(ctx, logChan, podName, options.Container,
+					fmt.Sprintf("log stream lost after %d reconnect attempts", maxLogReconnects))
+				errChan <- fmt.Errorf("error reading log stream for pod %s: %w", podName, err)
+				return
+			}
+			if err != nil {
+				log.Printf("⚠️ Pod %s 로그 스트림 끊김, 재연결합니다 (%d/%d): %v", podName, failures, maxLogReconnects, err)
 			}
 
 			select {
-			case logChan <- logEntry:
+			case <-time.After(logReconnectDelay(failures)):
 			case <-ctx.Done():
 				log.Printf("📜 Pod 로그 스트리밍 취소됨: %s", podName)
 				return
 			}
-		}
 
-		if err := scanner.Err(); err != nil {
-			errChan <- fmt.Errorf("error reading log stream for pod %s: %w", podName, err)
-			return
+			// 마지막으로 전달한 위치부터 이어서 읽기
+			if !resume.last.IsZero() {
+				podLogOpts.SinceTime = &metav1.Time{Time: resume.last}
+				podLogOpts.TailLines = nil
+				resume.resumed = true
+			}
 		}
-
-		log.Printf("📜 Pod 로그 스트리밍 완료: %s", podName)
 	}()
 
 	return logChan, errChan
 }
 
+// streamPodLogsOnce는 로그 스트림을 한 번 열어 끝나거나 끊길 때까지 전달합니다
+func (c *Client) streamPodLogsOnce(ctx context.Context, podName string, podLogOpts *v1.PodLogOptions, resume *logResume, logChan chan<- LogEntry) error {
+	logRequest := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, podLogOpts)
+	stream, err := logRequest.Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get log stream for pod %s: %w", podName, err)
+	}
+	defer stream.Close()
+
+	// 로그 라인별로 파싱 (긴 라인이나 바이너리 출력에도 중단되지 않음)
+	reader := NewLineReader(stream, MaxLogLineBytes)
+
+	// 긴 라인의 조각 상태 (타임스탬프는 첫 조각에만 있음)
+	var (
+		part      int
+		lineTime  *time.Time
+		skipLine  bool
+		continued bool
+	)
+	for {
+		line, more, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if continued {
+			part++
+		} else {
+			part, lineTime, skipLine = 0, nil, false
+			if more {
+				part = 1
+			}
+		}
+		continued = more
+
+		if line == "" && part == 0 {
+			continue
+		}
+
+		logEntry := LogEntry{
+			PodName:   podName,
+			Container: podLogOpts.Container,
+			Timestamp: time.Now(),
+			Message:   line,
+			Source:    "stdout", // Kubernetes logs API는 stdout/stderr 구분이 어려움
+			Part:      part,
+			Continued: more,
+		}
+
+		if part <= 1 {
+			// 타임스탬프 파싱
+			parsed, msg := c.parseTimestampedLog(line)
+			if parsed != nil {
+				logEntry.Timestamp = *parsed
+				logEntry.Message = msg
+			}
+			lineTime = parsed
+
+			// 재연결 후 이미 전달한 라인은 건너뜀 (이어지는 조각도 함께)
+			skipLine = !resume.accept(logEntry, parsed != nil)
+		} else if lineTime != nil {
+			logEntry.Timestamp = *lineTime
+		}
+		if skipLine {
+			continue
+		}
+
+		select {
+		case logChan <- logEntry:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // parseTimestampedLog은 타임스탬프가 포함된 로그를 파싱합니다
 func (c *Client) parseTimestampedLog(line string) (*time.Time, string) {
 	// Kubernetes 로그 형식: 2006-01-02T15:04:05.999999999Z message
@@ -346,73 +628,3 @@ func (c *Client) GetPodLogs(ctx context.Context, podName string, options LogStre
 
 	return string(logs), nil
 }
-
-// StreamMultiplePodLogs는 여러 Pod의 로그를 동시에 스트리밍합니다.
-//
-// StreamMultiplePodLogs는 라벨 셀렉터로 선택된 여러 Pod의 로그를
-// 하나의 채널로 통합하여 전달합니다. 각 로그 엔트리에는 Pod 이름이 포함되어
-// 어느 Pod에서 온 로그인지 구분할 수 있습니다.
-func (c *Client) StreamMultiplePodLogs(ctx context.Context, labelSelector string, options LogStreamOptions) (<-chan LogEntry, <-chan error) {
-	logChan := make(chan LogEntry, 100)
-	errChan := make(chan error, 1)
-
-	go func() {
-		defer close(logChan)
-		defer close(errChan)
-
-		// Pod 목록 조회
-		pods, err := c.ListPods(ctx, labelSelector)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to list pods with selector %s: %w", labelSelector, err)
-			return
-		}
-
-		if len(pods.Items) == 0 {
-			log.Printf("📜 셀렉터로 Pod를 찾을 수 없음: %s", labelSelector)
-			return
-		}
-
-		log.Printf("📜 %d개 Pod의 로그 스트리밍 시작", len(pods.Items))
-
-		// 각 Pod에 대해 로그 스트리밍 시작
-		for _, pod := range pods.Items {
-			go func(podName string) {
-				podLogChan, podErrChan := c.StreamPodLogs(ctx, podName, options)
-
-				// Pod 로그를 통합 채널로 전달
-				for {
-					select {
-					case logEntry, ok := <-podLogChan:
-						if !ok {
-							return
-						}
-						select {
-						case logChan <- logEntry:
-						case <-ctx.Done():
-							return
-						}
-					case err, ok := <-podErrChan:
-						if !ok {
-							return
-						}
-						if err != nil {
-							select {
-							case errChan <- err:
-							case <-ctx.Done():
-								return
-							}
-						}
-					case <-ctx.Done():
-						return
-					}
-				}
-			}(pod.Name)
-		}
-
-		// Context 완료까지 대기
-		<-ctx.Done()
-		log.Printf("📜 다중 Pod 로그 스트리밍 취소됨")
-	}()
-
-	return logChan, errChan
-}
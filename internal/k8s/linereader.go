@@ -0,0 +1,84 @@
+package k8s
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLogLineBytes는 한 번에 읽는 로그 라인 조각의 최대 크기입니다 (초과분은 다음 조각으로 이어짐)
+const MaxLogLineBytes = 8 * 1024 * 1024
+
+// LineReader reads log lines without failing on oversized or binary content.
+//
+// LineReader는 로그 스트림을 라인 단위로 읽습니다. bufio.Scanner와 달리
+// 아무리 긴 라인이나 바이너리 출력을 만나도 스트림을 중단하지 않습니다.
+//   - maxBytes를 넘는 라인은 maxBytes 이하의 조각으로 나누어 차례로 반환 (내용은 버리지 않음)
+//   - 잘못된 UTF-8 바이트는 U+FFFD로 대체 (조각 경계에서 문자를 자르지 않음)
+//   - 줄 끝의 \r\n, \n은 제거
+type LineReader struct {
+	reader   *bufio.Reader
+	maxBytes int
+	carry    []byte // 앞 조각을 반환하고 남은 같은 라인의 나머지
+}
+
+// NewLineReader creates a line reader. maxBytes <= 0 uses MaxLogLineBytes.
+//
+// NewLineReader는 LineReader를 생성합니다. maxBytes가 0 이하면 MaxLogLineBytes를 사용합니다.
+func NewLineReader(r io.Reader, maxBytes int) *LineReader {
+	if maxBytes <= 0 {
+		maxBytes = MaxLogLineBytes
+	}
+	return &LineReader{reader: bufio.NewReaderSize(r, 64*1024), maxBytes: maxBytes}
+}
+
+// Next returns the next line, or the next piece of a line longer than maxBytes
+// (continued is true while more pieces of the same line follow). At the end of
+// the stream it returns io.EOF (a final line without a newline is returned first).
+//
+// Next는 다음 라인을 반환합니다. maxBytes보다 긴 라인은 조각으로 나누어 반환하며,
+// 같은 라인의 조각이 더 남아 있으면 continued가 true입니다.
+// 스트림이 끝나면 io.EOF를 반환하며, 줄바꿈 없이 끝난 마지막 라인은 먼저 반환합니다.
+func (l *LineReader) Next() (line string, continued bool, err error) {
+	buf := l.carry
+	l.carry = nil
+
+	ended := bytes.IndexByte(buf, '\n') >= 0
+	var readErr error
+	for !ended && len(buf) <= l.maxBytes {
+		var chunk []byte
+		chunk, readErr = l.reader.ReadSlice('\n')
+		buf = append(buf, chunk...)
+		if errors.Is(readErr, bufio.ErrBufferFull) {
+			readErr = nil
+			continue
+		}
+		ended = true
+	}
+	if len(buf) == 0 && readErr != nil {
+		return "", false, readErr
+	}
+
+	// ReadSlice는 줄바꿈에서 멈추므로 줄바꿈은 항상 마지막 바이트
+	text := buf
+	if ended && len(text) > 0 && text[len(text)-1] == '\n' {
+		text = bytes.TrimSuffix(text[:len(text)-1], []byte("\r"))
+	}
+
+	if len(text) > l.maxBytes {
+		cut := l.maxBytes
+		for back := 0; back < utf8.UTFMax && cut > 0 && !utf8.RuneStart(text[cut]); back++ {
+			cut--
+		}
+		if cut == 0 {
+			cut = l.maxBytes
+		}
+		l.carry = append([]byte(nil), buf[cut:]...)
+		return strings.ToValidUTF8(string(text[:cut]), "�"), true, nil
+	}
+
+	return strings.ToValidUTF8(string(text), "�"), false, nil
+}
@@ -0,0 +1,223 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// LogMarkerGap은 재연결 실패나 컨테이너 재시작으로 읽지 못한 로그 구간을 나타내는 Marker입니다
+	LogMarkerGap = "gap"
+
+	// maxLogReconnects는 진행 없이 연속으로 재연결을 시도하는 최대 횟수입니다
+	maxLogReconnects = 10
+	// minLogReconnectDelay, maxLogReconnectDelay는 재연결 간격의 범위입니다
+	minLogReconnectDelay = 500 * time.Millisecond
+	maxLogReconnectDelay = 30 * time.Second
+)
+
+// logResume은 재연결 시 이어서 읽을 위치와 중복 제거 상태를 추적합니다
+type logResume struct {
+	last      time.Time           // 마지막으로 전달한 라인의 타임스탬프
+	seen      map[uint64]struct{} // last와 같은 타임스탬프로 전달한 라인의 해시
+	resumed   bool                // 재연결 직후 (last 이전 라인은 이미 전달됨)
+	restarts  int32               // 마지막으로 확인한 컨테이너 재시작 횟수
+	delivered int64               // 전달한 라인 수
+}
+
+// newLogResume은 빈 재연결 상태를 생성합니다
+func newLogResume() *logResume {
+	return &logResume{seen: make(map[uint64]struct{})}
+}
+
+// accept는 라인을 전달해야 하는지 판단하고 위치를 갱신합니다.
+// 재연결 직후에는 last 이전이거나, last와 같은 타임스탬프에 같은 내용인 라인을 중복으로 봅니다.
+func (r *logResume) accept(entry LogEntry, hasTimestamp bool) bool {
+	if !hasTimestamp {
+		r.delivered++
+		return true
+	}
+
+	hash := lineHash(entry.Message)
+	if r.resumed {
+		if entry.Timestamp.Before(r.last) {
+			return false
+		}
+		if entry.Timestamp.Equal(r.last) {
+			if _, seen := r.seen[hash]; seen {
+				return false
+			}
+		} else {
+			r.resumed = false
+		}
+	}
+
+	if !entry.Timestamp.Equal(r.last) {
+		r.last = entry.Timestamp
+		r.seen = make(map[uint64]struct{})
+	}
+	r.seen[hash] = struct{}{}
+	r.delivered++
+	return true
+}
+
+// sendGap은 읽지 못한 로그 구간을 알리는 Marker 엔트리를 전달합니다
+func (r *logResume) sendGap(ctx context.Context, logChan chan<- LogEntry, podName, container, reason string) {
+	log.Printf("⚠️ Pod %s 로그 유실 구간 (container: %s): %s", podName, container, reason)
+
+	marker := LogEntry{
+		PodName:   podName,
+		Container: container,
+		Timestamp: time.Now(),
+		Message:   "[ottoscaler] log gap: " + reason,
+		Source:    "ottoscaler",
+		Marker:    LogMarkerGap,
+	}
+	select {
+	case logChan <- marker:
+	case <-ctx.Done():
+	}
+}
+
+// lineHash는 라인 내용의 해시를 반환합니다
+func lineHash(message string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(message))
+	return h.Sum64()
+}
+
+// logReconnectDelay는 연속 실패 횟수에 따른 재연결 대기 시간을 반환합니다
+func logReconnectDelay(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	delay := minLogReconnectDelay << min(failures-1, 6)
+	if delay > maxLogReconnectDelay {
+		delay = maxLogReconnectDelay
+	}
+	return delay
+}
+
+// checkLogResume은 스트림이 끝난 뒤 컨테이너 상태를 확인합니다.
+//
+// 컨테이너가 재시작되었으면 이전 컨테이너의 남은 로그를 전달하고 (재시작이 여러 번이라
+// 읽을 수 없는 구간은 Gap Marker로 알림) 새 컨테이너로 이어가도록 false를 반환합니다.
+// 컨테이너가 종료되어 더 이상 로그가 없거나 Pod가 삭제되었으면 true를 반환합니다.
+func (c *Client) checkLogResume(ctx context.Context, podName, container string, resume *logResume, logChan chan<- LogEntry) (bool, error) {
+	pod, err := c.GetPod(ctx, podName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	status := findContainerStatus(pod, container)
+	if status == nil {
+		return false, nil
+	}
+
+	if status.RestartCount > resume.restarts {
+		if missed := status.RestartCount - resume.restarts - 1; missed > 0 {
+			resume.sendGap(ctx, logChan, podName, container,
+				fmt.Sprintf("container restarted %d more time(s) while disconnected, their logs are unavailable", missed))
+		}
+
+		log.Printf("🔄 Pod %s의 컨테이너 %s 재시작 감지 (재시작 %d회), 이전 컨테이너 로그를 이어서 읽습니다",
+			podName, status.Name, status.RestartCount)
+		c.streamPreviousLogs(ctx, podName, status.Name, resume, logChan)
+
+		resume.restarts = status.RestartCount
+		return false, nil
+	}
+
+	if status.State.Terminated == nil {
+		return false, nil
+	}
+
+	// 종료된 컨테이너가 다시 시작되지 않으면 스트림 완료
+	switch {
+	case pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed:
+		return true, nil
+	case pod.Spec.RestartPolicy == v1.RestartPolicyNever:
+		return true, nil
+	case pod.Spec.RestartPolicy == v1.RestartPolicyOnFailure && status.State.Terminated.ExitCode == 0:
+		return true, nil
+	case IsInitContainer(pod, status.Name) && status.State.Terminated.ExitCode == 0:
+		// 성공한 init 컨테이너는 RestartPolicy와 관계없이 다시 실행되지 않음
+		return true, nil
+	}
+	return false, nil
+}
+
+// readPreviousLogs는 스트리밍을 시작할 때 컨테이너가 이미 재시작된 상태면 이전 컨테이너의
+// 로그를 먼저 전달합니다. 그보다 앞선 인스턴스의 로그는 Kubernetes가 보관하지 않으므로 Gap으로 알립니다.
+func (c *Client) readPreviousLogs(ctx context.Context, podName, container string, resume *logResume, logChan chan<- LogEntry) {
+	if resume.restarts <= 0 {
+		return
+	}
+	if missed := resume.restarts - 1; missed > 0 {
+		resume.sendGap(ctx, logChan, podName, container,
+			fmt.Sprintf("container restarted %d time(s) before log streaming started, only the last previous instance's logs are available", resume.restarts))
+	}
+
+	log.Printf("🔄 Pod %s의 컨테이너 %s가 이미 %d회 재시작됨, 이전 컨테이너 로그를 먼저 읽습니다",
+		podName, container, resume.restarts)
+	c.streamPreviousLogs(ctx, podName, container, resume, logChan)
+}
+
+// streamPreviousLogs는 이전 컨테이너 인스턴스의 (아직 전달하지 않은) 로그를 전달합니다
+func (c *Client) streamPreviousLogs(ctx context.Context, podName, container string, resume *logResume, logChan chan<- LogEntry) {
+	previousOpts := &v1.PodLogOptions{
+		Container:  container,
+		Timestamps: true,
+		Previous:   true,
+	}
+	if !resume.last.IsZero() {
+		previousOpts.SinceTime = &metav1.Time{Time: resume.last}
+		resume.resumed = true
+	}
+	if err := c.streamPodLogsOnce(ctx, podName, previousOpts, resume, logChan); err != nil && ctx.Err() == nil {
+		resume.sendGap(ctx, logChan, podName, container,
+			fmt.Sprintf("failed to read logs of the previous container: %v", err))
+	}
+}
+
+// IsInitContainer reports whether container is a run-to-completion init container.
+//
+// IsInitContainer는 컨테이너가 한 번 실행되고 끝나는 init 컨테이너인지 반환합니다
+// (restartPolicy: Always인 네이티브 사이드카는 제외).
+func IsInitContainer(pod *v1.Pod, container string) bool {
+	for _, init := range pod.Spec.InitContainers {
+		if init.Name == container {
+			return init.RestartPolicy == nil || *init.RestartPolicy != v1.ContainerRestartPolicyAlways
+		}
+	}
+	return false
+}
+
+// findContainerStatus는 컨테이너 상태를 찾습니다 (이름이 비어있으면 첫 번째 컨테이너)
+func findContainerStatus(pod *v1.Pod, container string) *v1.ContainerStatus {
+	if container == "" {
+		if len(pod.Status.ContainerStatuses) == 0 {
+			return nil
+		}
+		return &pod.Status.ContainerStatuses[0]
+	}
+
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for i := range statuses {
+			if statuses[i].Name == container {
+				return &statuses[i]
+			}
+		}
+	}
+	return nil
+}
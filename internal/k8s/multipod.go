@@ -0,0 +1,326 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// StreamMultiplePodLogs는 여러 Pod의 로그를 동시에 스트리밍합니다.
+//
+// StreamMultiplePodLogs는 라벨 셀렉터로 선택된 여러 Pod의 로그를
+// 하나의 채널로 통합하여 전달합니다. 각 로그 엔트리에는 Pod 이름이 포함되어
+// 어느 Pod에서 온 로그인지 구분할 수 있습니다.
+//
+// Follow면 셀렉터를 Watch하여 나중에 생성된 Pod(이후 샤드, 재시도된 스테이지)의
+// 로그 스트림을 붙이고, 삭제된 Pod의 스트림은 분리합니다. UntilAllFinished가 설정되면
+// 발견한 모든 Pod가 종료(Succeeded/Failed 또는 삭제)되고 로그를 모두 전달한 뒤 채널을 닫습니다.
+// Follow가 아니면 현재 Pod들의 로그를 전달한 뒤 채널을 닫습니다.
+func (c *Client) StreamMultiplePodLogs(ctx context.Context, labelSelector string, options LogStreamOptions) (<-chan LogEntry, <-chan error) {
+	logChan := make(chan LogEntry, 100)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(logChan)
+		defer close(errChan)
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &multiPodStream{
+			client:   c,
+			selector: labelSelector,
+			options:  options,
+			logChan:  logChan,
+			errChan:  errChan,
+			pods:     make(map[types.UID]*followedPod),
+			ended:    make(chan types.UID),
+		}
+
+		stream.run(streamCtx)
+
+		// Pod별 스트림이 모두 끝난 뒤 채널을 닫음
+		cancel()
+		stream.wg.Wait()
+	}()
+
+	return logChan, errChan
+}
+
+// multiPodStream은 셀렉터로 선택된 Pod들의 로그 스트림을 붙이고 분리합니다
+type multiPodStream struct {
+	client   *Client
+	selector string
+	options  LogStreamOptions
+	logChan  chan<- LogEntry
+	errChan  chan<- error
+
+	// run 고루틴만 접근
+	pods  map[types.UID]*followedPod
+	ended chan types.UID // Pod별 스트림이 끝나면 UID 전달
+	wg    sync.WaitGroup
+}
+
+// followedPod은 로그를 따라가는 Pod 하나의 상태입니다
+type followedPod struct {
+	name      string
+	cancel    context.CancelFunc
+	streaming bool // 로그 스트림이 진행 중
+	finished  bool // Pod가 종료되었거나 삭제됨
+}
+
+// run은 Pod 목록을 동기화하고 Watch 이벤트에 따라 스트림을 관리합니다
+func (m *multiPodStream) run(ctx context.Context) {
+	resourceVersion, err := m.sync(ctx)
+	if err != nil {
+		m.errChan <- err
+		return
+	}
+
+	if len(m.pods) == 0 {
+		log.Printf("📜 셀렉터로 Pod를 찾을 수 없음: %s", m.selector)
+	} else {
+		log.Printf("📜 %d개 Pod의 로그 스트리밍 시작", len(m.pods))
+	}
+
+	if !m.options.Follow {
+		m.waitStreams(ctx)
+		return
+	}
+
+	failures := 0
+	for {
+		if resourceVersion == "" {
+			if resourceVersion, err = m.sync(ctx); err != nil {
+				failures++
+			}
+		}
+		if resourceVersion != "" {
+			resourceVersion, err = m.watch(ctx, resourceVersion)
+			if err == nil {
+				failures = 0
+			} else {
+				failures++
+			}
+		}
+
+		if ctx.Err() != nil {
+			log.Printf("📜 다중 Pod 로그 스트리밍 취소됨")
+			return
+		}
+		if m.complete() {
+			log.Printf("🏁 셀렉터 %s의 모든 Pod 종료, 다중 Pod 로그 스트리밍 완료", m.selector)
+			return
+		}
+		if failures > maxLogReconnects {
+			m.errChan <- fmt.Errorf("failed to watch pods with selector %s: %w", m.selector, err)
+			return
+		}
+		if err != nil {
+			log.Printf("⚠️ Pod Watch 끊김 (셀렉터: %s), 다시 시작합니다 (%d/%d): %v", m.selector, failures, maxLogReconnects, err)
+		}
+
+		select {
+		case <-time.After(logReconnectDelay(failures)):
+		case <-ctx.Done():
+			log.Printf("📜 다중 Pod 로그 스트리밍 취소됨")
+			return
+		}
+	}
+}
+
+// sync는 Pod 목록을 조회해 새 Pod를 붙이고, 목록에서 사라진 Pod를 분리합니다.
+// Watch를 이어갈 resourceVersion을 반환합니다.
+func (m *multiPodStream) sync(ctx context.Context) (string, error) {
+	pods, err := m.client.ListPods(ctx, m.selector)
+	if err != nil {
+		return "", err
+	}
+
+	listed := make(map[types.UID]bool, len(pods.Items))
+	for i := range pods.Items {
+		listed[pods.Items[i].UID] = true
+		m.observe(ctx, &pods.Items[i])
+	}
+	for uid, pod := range m.pods {
+		if !listed[uid] && !pod.finished {
+			m.remove(uid)
+		}
+	}
+	return pods.ResourceVersion, nil
+}
+
+// watch는 resourceVersion부터 Pod 변경을 따라갑니다. Watch가 끝나거나 모든 Pod가
+// 종료되면 이어갈 resourceVersion을 반환하고, 목록을 다시 조회해야 하면 빈 값을 반환합니다.
+func (m *multiPodStream) watch(ctx context.Context, resourceVersion string) (string, error) {
+	watcher, err := m.client.clientset.CoreV1().Pods(m.client.namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector:       m.selector,
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to watch pods: %w", err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+			if event.Type == watch.Error {
+				// 보통 resourceVersion이 만료된 경우 (410 Gone)
+				return "", fmt.Errorf("watch error: %v", event.Object)
+			}
+
+			pod, ok := event.Object.(*v1.Pod)
+			if !ok {
+				continue
+			}
+			resourceVersion = pod.ResourceVersion
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				m.observe(ctx, pod)
+			case watch.Deleted:
+				m.remove(pod.UID)
+			}
+
+		case uid := <-m.ended:
+			m.streamEnded(uid)
+
+		case <-ctx.Done():
+			return resourceVersion, nil
+		}
+
+		if m.complete() {
+			return resourceVersion, nil
+		}
+	}
+}
+
+// observe는 처음 보는 Pod의 로그 스트림을 붙이고 종료 여부를 기록합니다
+func (m *multiPodStream) observe(ctx context.Context, pod *v1.Pod) {
+	followed, exists := m.pods[pod.UID]
+	if !exists {
+		followed = &followedPod{name: pod.Name}
+		m.pods[pod.UID] = followed
+		m.attach(ctx, pod.UID, followed)
+	}
+
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		followed.finished = true
+	}
+}
+
+// remove는 삭제된 Pod의 로그 스트림을 분리합니다
+func (m *multiPodStream) remove(uid types.UID) {
+	followed, exists := m.pods[uid]
+	if !exists || followed.finished && !followed.streaming {
+		return
+	}
+
+	followed.finished = true
+	if followed.streaming {
+		log.Printf("📜 Pod %s 삭제됨, 로그 스트림 분리", followed.name)
+		followed.cancel()
+	}
+}
+
+// streamEnded는 Pod별 스트림이 끝났음을 기록합니다
+func (m *multiPodStream) streamEnded(uid types.UID) {
+	if followed, exists := m.pods[uid]; exists {
+		followed.streaming = false
+		followed.cancel()
+	}
+}
+
+// complete는 UntilAllFinished일 때 발견한 모든 Pod가 종료되고 스트림이 끝났는지 반환합니다
+func (m *multiPodStream) complete() bool {
+	if !m.options.UntilAllFinished || len(m.pods) == 0 {
+		return false
+	}
+	for _, followed := range m.pods {
+		if followed.streaming || !followed.finished {
+			return false
+		}
+	}
+	return true
+}
+
+// waitStreams는 모든 Pod별 스트림이 끝날 때까지 기다립니다 (Follow가 아닐 때)
+func (m *multiPodStream) waitStreams(ctx context.Context) {
+	for {
+		streaming := false
+		for _, followed := range m.pods {
+			streaming = streaming || followed.streaming
+		}
+		if !streaming {
+			return
+		}
+
+		select {
+		case uid := <-m.ended:
+			m.streamEnded(uid)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// attach는 Pod의 로그 스트림을 시작해 통합 채널로 전달합니다
+func (m *multiPodStream) attach(ctx context.Context, uid types.UID, followed *followedPod) {
+	podCtx, cancel := context.WithCancel(ctx)
+	followed.cancel = cancel
+	followed.streaming = true
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.forward(podCtx, followed.name)
+
+		select {
+		case m.ended <- uid:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// forward는 Pod 하나의 로그를 통합 채널로 전달합니다
+func (m *multiPodStream) forward(ctx context.Context, podName string) {
+	podLogChan, podErrChan := m.client.StreamPodLogs(ctx, podName, m.options)
+
+	for {
+		select {
+		case logEntry, ok := <-podLogChan:
+			if !ok {
+				return
+			}
+			select {
+			case m.logChan <- logEntry:
+			case <-ctx.Done():
+				return
+			}
+		case err, ok := <-podErrChan:
+			if !ok {
+				podErrChan = nil
+				continue
+			}
+			if err != nil {
+				select {
+				case m.errChan <- err:
+				default:
+					log.Printf("⚠️ Pod %s 로그 스트리밍 오류: %v", podName, err)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package logs
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ANSIMode controls how ANSI escape sequences in log messages are handled.
+//
+// ANSIMode는 로그 메시지의 ANSI 이스케이프 시퀀스(색상 등) 처리 방식입니다.
+// 레벨과 스택 트레이스 감지는 모드와 관계없이 시퀀스를 제거한 텍스트로 수행합니다.
+type ANSIMode string
+
+const (
+	// ANSIKeep은 시퀀스를 그대로 둡니다
+	ANSIKeep ANSIMode = "keep"
+	// ANSIStrip은 모든 시퀀스를 제거합니다
+	ANSIStrip ANSIMode = "strip"
+	// ANSIHTML은 색상/굵게(SGR)를 <span class="ansi-..."> 로 변환하고 나머지 시퀀스는 제거합니다
+	ANSIHTML ANSIMode = "html"
+)
+
+var (
+	// CSI (ESC [ ... 종료 문자), OSC (ESC ] ... BEL 또는 ESC \), 그 외 2바이트 ESC 시퀀스
+	ansiSequence = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[@-Z\\-_])`)
+	sgrSequence  = regexp.MustCompile(`^\x1b\[([0-9;]*)m$`)
+)
+
+var ansiColorNames = [...]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ParseANSIMode parses a configured ANSI mode. An empty value means keep.
+//
+// ParseANSIMode는 설정 값을 ANSIMode로 변환합니다. 빈 값은 keep입니다.
+func ParseANSIMode(value string) (ANSIMode, error) {
+	switch mode := ANSIMode(strings.ToLower(value)); mode {
+	case "":
+		return ANSIKeep, nil
+	case ANSIKeep, ANSIStrip, ANSIHTML:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported ANSI mode %q (expected keep, strip or html)", value)
+	}
+}
+
+// Apply renders text according to the mode.
+//
+// Apply는 모드에 따라 텍스트를 변환합니다.
+func (m ANSIMode) Apply(text string) string {
+	switch m {
+	case ANSIStrip:
+		return StripANSI(text)
+	case ANSIHTML:
+		return ANSIToHTML(text)
+	default:
+		return text
+	}
+}
+
+// StripANSI removes ANSI escape sequences from text.
+//
+// StripANSI는 텍스트에서 ANSI 이스케이프 시퀀스를 제거합니다.
+func StripANSI(text string) string {
+	if !strings.Contains(text, "\x1b") {
+		return text
+	}
+	return ansiSequence.ReplaceAllString(text, "")
+}
+
+// ANSIToHTML converts SGR colors to HTML spans and escapes the rest of the text.
+//
+// ANSIToHTML은 SGR 색상과 굵게를 <span class="ansi-<색상>">, <span class="ansi-bold">로
+// 변환합니다. 나머지 텍스트는 HTML 이스케이프되며, 그 외 시퀀스는 제거됩니다.
+func ANSIToHTML(text string) string {
+	if !strings.Contains(text, "\x1b") {
+		return html.EscapeString(text)
+	}
+
+	var out strings.Builder
+	open := 0
+	closeAll := func() {
+		for ; open > 0; open-- {
+			out.WriteString("</span>")
+		}
+	}
+
+	last := 0
+	for _, loc := range ansiSequence.FindAllStringIndex(text, -1) {
+		out.WriteString(html.EscapeString(text[last:loc[0]]))
+		last = loc[1]
+
+		match := sgrSequence.FindStringSubmatch(text[loc[0]:loc[1]])
+		if match == nil {
+			continue
+		}
+		for _, class := range sgrClasses(match[1]) {
+			if class == "" {
+				closeAll()
+				continue
+			}
+			out.WriteString(`<span class="` + class + `">`)
+			open++
+		}
+	}
+	out.WriteString(html.EscapeString(text[last:]))
+	closeAll()
+	return out.String()
+}
+
+// sgrClasses는 SGR 파라미터를 CSS 클래스 목록으로 변환합니다 (빈 문자열은 초기화)
+func sgrClasses(params string) []string {
+	if params == "" {
+		return []string{""}
+	}
+
+	var classes []string
+	for _, param := range strings.Split(params, ";") {
+		code, err := strconv.Atoi(param)
+		if err != nil {
+			continue
+		}
+		switch {
+		case code == 0:
+			classes = append(classes, "")
+		case code == 1:
+			classes = append(classes, "ansi-bold")
+		case code >= 30 && code <= 37:
+			classes = append(classes, "ansi-"+ansiColorNames[code-30])
+		case code >= 90 && code <= 97:
+			classes = append(classes, "ansi-bright-"+ansiColorNames[code-90])
+		case code >= 40 && code <= 47:
+			classes = append(classes, "ansi-bg-"+ansiColorNames[code-40])
+		case code >= 100 && code <= 107:
+			classes = append(classes, "ansi-bg-bright-"+ansiColorNames[code-100])
+		}
+	}
+	return classes
+}
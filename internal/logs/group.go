@@ -0,0 +1,292 @@
+package logs
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+)
+
+const (
+	// MaxGroupLines는 하나의 엔트리로 묶을 수 있는 최대 라인 수입니다
+	MaxGroupLines = 500
+	// MaxGroupBytes는 하나의 엔트리로 묶을 수 있는 최대 메시지 크기입니다
+	MaxGroupBytes = 64 * 1024
+)
+
+// Record is a parsed log entry, possibly spanning multiple lines.
+//
+// Record는 분석이 끝난 로그 엔트리입니다. 스택 트레이스는 여러 라인이
+// 하나의 Record로 묶이며, Entry.Message에 줄바꿈으로 연결됩니다.
+type Record struct {
+	Entry  k8s.LogEntry      // 원본 엔트리 (첫 라인의 타임스탬프, 메시지는 분석 결과)
+	Level  string            // 정규화된 레벨
+	Fields map[string]string // 추출된 구조화 필드
+	Lines  int               // 묶인 라인 수
+}
+
+// stackKind는 스택 트레이스 블록의 종류입니다
+type stackKind string
+
+const (
+	stackGo     stackKind = "go"
+	stackPython stackKind = "python"
+	stackJava   stackKind = "java"
+)
+
+var (
+	goPanicStart      = regexp.MustCompile(`^(panic: |fatal error: |goroutine \d+ \[)`)
+	goFrameLine       = regexp.MustCompile(`^(created by |[\w./*%()-]+\(.*\)$|\[signal |exit status \d+$)`)
+	pythonStart       = regexp.MustCompile(`^Traceback \(most recent call last\):$`)
+	pythonChainedLine = regexp.MustCompile(`^(During handling of the above exception|The above exception was the direct cause)`)
+	pythonErrorLine   = regexp.MustCompile(`^[\w.]+(Error|Exception|Exit|Interrupt|Warning)(: .*)?$`)
+	javaStart         = regexp.MustCompile(`^(Exception in thread "|[\w.$]+(Exception|Error)(: .*)?$)`)
+	javaFrameLine     = regexp.MustCompile(`^\s+(at |\.\.\. \d+ more)|^Caused by: `)
+)
+
+// Processor groups multi-line stack traces and runs the parser chain.
+//
+// Processor는 컨테이너 하나의 로그 스트림을 처리합니다.
+// 스택 트레이스의 시작 라인을 만나면 이어지는 라인들을 모아 하나의 Record로
+// 만들고, 그 외 라인은 Parser 체인으로 레벨과 필드를 추출합니다.
+// 스트림마다 별도의 Processor를 사용해야 하며, 동시 호출에 안전하지 않습니다.
+type Processor struct {
+	parsers []Parser
+	ansi    ANSIMode
+
+	// 진행 중인 스택 트레이스 블록
+	pending *Record
+	kind    stackKind
+	lines   []string // ANSI 시퀀스를 제거한 라인 (감지용)
+	raw     []string // 원본 라인 (출력용)
+	size    int
+}
+
+// NewProcessor creates a processor with the given parser chain.
+//
+// NewProcessor는 주어진 Parser 체인을 사용하는 Processor를 생성합니다.
+func NewProcessor(parsers ...Parser) *Processor {
+	return &Processor{parsers: parsers}
+}
+
+// WithANSI sets how ANSI escape sequences are rendered and returns the processor.
+//
+// WithANSI는 메시지의 ANSI 이스케이프 시퀀스 처리 방식을 지정하고 Processor를 반환합니다.
+// 기본값(빈 값)은 ANSIKeep입니다.
+func (p *Processor) WithANSI(mode ANSIMode) *Processor {
+	p.ansi = mode
+	return p
+}
+
+// Process consumes a log entry and returns the records completed by it.
+//
+// Process는 로그 엔트리 하나를 처리하고, 그로 인해 완성된 Record들을 반환합니다.
+// 스택 트레이스를 모으는 중이면 빈 결과를 반환할 수 있습니다 (Pending 참고).
+func (p *Processor) Process(entry k8s.LogEntry) []Record {
+	// 로그 유실 등 표시 엔트리는 분석하지 않고 그대로 전달
+	if entry.Marker != "" {
+		return append(p.Flush(), Record{
+			Entry:  entry,
+			Level:  LevelWarn,
+			Fields: map[string]string{"marker": entry.Marker},
+			Lines:  1,
+		})
+	}
+
+	// 긴 라인의 조각은 묶지 않고 조각마다 하나의 Record로 전달
+	if entry.Part > 0 {
+		raw := entry.Message
+		entry.Message = StripANSI(raw)
+		return p.finish(append(p.Flush(), p.parse(entry, raw)))
+	}
+
+	return p.finish(p.process(entry))
+}
+
+// process는 ANSI 시퀀스를 제거한 텍스트로 스택 트레이스를 묶고 레벨을 감지합니다
+func (p *Processor) process(entry k8s.LogEntry) []Record {
+	var records []Record
+
+	raw := entry.Message
+	entry.Message = StripANSI(raw)
+
+	if p.pending != nil {
+		if p.continues(entry.Message) && p.size+len(entry.Message) < MaxGroupBytes && len(p.lines) < MaxGroupLines {
+			p.lines = append(p.lines, entry.Message)
+			p.raw = append(p.raw, raw)
+			p.size += len(entry.Message) + 1
+			if p.kind == stackPython && pythonErrorLine.MatchString(entry.Message) {
+				// Python traceback은 예외 메시지 라인으로 끝남
+				records = append(records, p.flushPending())
+			}
+			return records
+		}
+		records = append(records, p.flushPending())
+	}
+
+	if kind, ok := stackStart(entry.Message); ok {
+		p.pending = &Record{Entry: entry}
+		p.kind = kind
+		p.lines = []string{entry.Message}
+		p.raw = []string{raw}
+		p.size = len(entry.Message)
+		return records
+	}
+
+	return append(records, p.parse(entry, raw))
+}
+
+// Pending reports whether a multi-line block is waiting for more lines.
+//
+// Pending은 여러 줄 블록이 다음 라인을 기다리는 중인지 반환합니다.
+// 호출자는 일정 시간 동안 새 라인이 없으면 Flush를 호출해야 합니다.
+func (p *Processor) Pending() bool {
+	return p.pending != nil
+}
+
+// Flush returns any buffered multi-line block as a record.
+//
+// Flush는 모으고 있던 여러 줄 블록을 Record로 반환합니다.
+func (p *Processor) Flush() []Record {
+	if p.pending == nil {
+		return nil
+	}
+	return p.finish([]Record{p.flushPending()})
+}
+
+// parse는 단일 라인에 Parser 체인을 적용합니다 (entry.Message는 ANSI 제거, raw는 원본)
+func (p *Processor) parse(entry k8s.LogEntry, raw string) Record {
+	record := Record{Entry: entry, Lines: 1}
+	record.Entry.Message = p.ansi.Apply(raw)
+
+	if result, ok := Parse(p.parsers, entry.Message); ok {
+		record.Level = result.Level
+		record.Fields = result.Fields
+		if result.Message != "" && result.Message != entry.Message {
+			record.Entry.Message = p.ansi.Apply(result.Message)
+		}
+	}
+	if record.Level == "" {
+		record.Level = defaultLevel(entry.Source)
+	}
+
+	return record
+}
+
+// flushPending은 모은 스택 트레이스를 하나의 Record로 만듭니다
+func (p *Processor) flushPending() Record {
+	// 블록 끝의 빈 라인은 제거
+	lines, raw := p.lines, p.raw
+	for len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	raw = raw[:len(lines)]
+
+	record := *p.pending
+	p.pending = nil
+	p.lines = nil
+	p.raw = nil
+	p.size = 0
+
+	// 시작 라인만 있으면 스택 트레이스가 아닌 일반 라인으로 처리
+	if len(lines) == 1 {
+		return p.parse(record.Entry, raw[0])
+	}
+
+	record.Entry.Message = p.ansi.Apply(strings.Join(raw, "\n"))
+	record.Level = LevelError
+	record.Lines = len(lines)
+	record.Fields = map[string]string{
+		"stacktrace": string(p.kind),
+		"lines":      strconv.Itoa(len(lines)),
+	}
+	return record
+}
+
+// continues는 라인이 진행 중인 스택 트레이스 블록에 속하는지 판단합니다
+func (p *Processor) continues(line string) bool {
+	if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "  ") {
+		return true
+	}
+
+	switch p.kind {
+	case stackGo:
+		return line == "" || goPanicStart.MatchString(line) || goFrameLine.MatchString(line)
+	case stackPython:
+		return line == "" || pythonStart.MatchString(line) || pythonChainedLine.MatchString(line) ||
+			pythonErrorLine.MatchString(line)
+	case stackJava:
+		return javaFrameLine.MatchString(line)
+	}
+	return false
+}
+
+// stackStart는 라인이 스택 트레이스 블록의 시작인지 판단합니다
+func stackStart(line string) (stackKind, bool) {
+	switch {
+	case goPanicStart.MatchString(line):
+		return stackGo, true
+	case pythonStart.MatchString(line):
+		return stackPython, true
+	case javaStart.MatchString(line):
+		return stackJava, true
+	}
+	return "", false
+}
+
+// defaultLevel은 레벨을 감지하지 못했을 때 스트림 종류로 레벨을 정합니다.
+// Kubernetes 로그 API는 stdout/stderr를 구분하지 않으므로 대부분 INFO가 됩니다.
+func defaultLevel(source string) string {
+	if source == "stderr" {
+		return LevelError
+	}
+	return LevelInfo
+}
+
+// finish는 MaxLogLineBytes를 넘는 라인의 조각을 Fields의 part(1부터)와 continued로 표시합니다
+func (p *Processor) finish(records []Record) []Record {
+	for i := range records {
+		if records[i].Entry.Part == 0 {
+			continue
+		}
+		fields := make(map[string]string, len(records[i].Fields)+2)
+		for key, value := range records[i].Fields {
+			fields[key] = value
+		}
+		fields["part"] = strconv.Itoa(records[i].Entry.Part)
+		if records[i].Entry.Continued {
+			fields["continued"] = "true"
+		}
+		records[i].Fields = fields
+	}
+	return records
+}
+
+// SplitMessage splits a message into parts of at most maxBytes on UTF-8 boundaries.
+//
+// SplitMessage는 메시지를 maxBytes 이하의 조각으로 나눕니다 (UTF-8 문자 경계 유지).
+// maxBytes가 0 이하거나 메시지가 짧으면 메시지 하나만 반환합니다.
+func SplitMessage(message string, maxBytes int) []string {
+	if maxBytes <= 0 || len(message) <= maxBytes {
+		return []string{message}
+	}
+
+	var chunks []string
+	for len(message) > maxBytes {
+		cut := maxBytes
+		for cut > 0 && !utf8.RuneStart(message[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			_, cut = utf8.DecodeRuneInString(message)
+		}
+		chunks = append(chunks, message[:cut])
+		message = message[cut:]
+	}
+	if message != "" {
+		chunks = append(chunks, message)
+	}
+	return chunks
+}
@@ -0,0 +1,273 @@
+// Package logs provides level and structure detection for collected worker logs.
+//
+// 이 패키지는 Worker Pod에서 수집된 로그 라인을 분석합니다.
+// Kubernetes 로그 API는 stdout/stderr를 구분하지 않으므로, 로그 레벨과
+// 구조화된 필드는 라인 내용에서 직접 추출해야 합니다.
+//
+// 주요 기능:
+//   - Parser 체인: JSON, logfmt, 레벨 접두사 순으로 시도
+//   - 여러 줄로 된 스택 트레이스(Go panic, Python traceback 등)를 하나의 엔트리로 묶기
+//   - ANSI 색상 코드 유지/제거/HTML 변환 (감지는 항상 색상 코드를 제거한 텍스트로 수행)
+//
+// 사용 예시:
+//
+//	processor := logs.NewProcessor(logs.DefaultParsers()...)
+//	for _, record := range processor.Process(entry) {
+//		fmt.Println(record.Level, record.Entry.Message, record.Fields)
+//	}
+//	records := processor.Flush() // 스트림 종료 시 남은 엔트리
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 정규화된 로그 레벨 (WorkerLogEntry.level 값)
+const (
+	LevelDebug = "DEBUG"
+	LevelInfo  = "INFO"
+	LevelWarn  = "WARN"
+	LevelError = "ERROR"
+)
+
+// Result is the outcome of parsing a single log line.
+//
+// Result는 로그 라인 하나의 분석 결과입니다.
+type Result struct {
+	Level   string            // 정규화된 레벨 (비어있으면 감지 실패)
+	Message string            // 표시할 메시지 (비어있으면 원본 라인 유지)
+	Fields  map[string]string // 추출된 구조화 필드
+}
+
+// Parser detects the level and structure of a log line.
+//
+// Parser는 로그 라인의 레벨과 구조를 감지합니다.
+// 라인을 인식하지 못하면 ok=false를 반환하고 체인의 다음 Parser가 시도됩니다.
+type Parser interface {
+	Parse(line string) (result Result, ok bool)
+}
+
+// ParserFunc adapts a function to the Parser interface.
+//
+// ParserFunc는 일반 함수를 Parser로 사용할 수 있게 합니다.
+type ParserFunc func(line string) (Result, bool)
+
+// Parse는 f(line)을 호출합니다
+func (f ParserFunc) Parse(line string) (Result, bool) {
+	return f(line)
+}
+
+// DefaultParsers returns the built-in parser chain.
+//
+// DefaultParsers는 기본 Parser 체인을 반환합니다 (JSON → logfmt → 레벨 접두사).
+func DefaultParsers() []Parser {
+	return []Parser{JSONParser{}, LogfmtParser{}, LevelPrefixParser{}}
+}
+
+// levelKeys와 messageKeys는 구조화 로그에서 레벨/메시지로 인식하는 키입니다
+var (
+	levelKeys   = []string{"level", "lvl", "severity", "log.level", "loglevel"}
+	messageKeys = []string{"msg", "message", "log"}
+)
+
+// JSONParser parses JSON object lines such as those written by zap, logrus or slog.
+//
+// JSONParser는 zap, logrus, slog 등이 출력하는 JSON 객체 로그를 분석합니다.
+type JSONParser struct{}
+
+// Parse는 JSON 객체 라인에서 레벨, 메시지, 나머지 필드를 추출합니다
+func (JSONParser) Parse(line string) (Result, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "{") || !strings.HasSuffix(trimmed, "}") {
+		return Result{}, false
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(trimmed), &object); err != nil {
+		return Result{}, false
+	}
+
+	fields := make(map[string]string, len(object))
+	for key, value := range object {
+		switch v := value.(type) {
+		case string:
+			fields[key] = v
+		case nil:
+			fields[key] = ""
+		case map[string]interface{}, []interface{}:
+			encoded, _ := json.Marshal(v)
+			fields[key] = string(encoded)
+		default:
+			fields[key] = fmt.Sprint(v)
+		}
+	}
+
+	return structuredResult(fields), true
+}
+
+// LogfmtParser parses logfmt lines (key=value pairs).
+//
+// LogfmtParser는 logfmt 형식(key=value 쌍) 로그를 분석합니다.
+// 평범한 문장을 잘못 인식하지 않도록 레벨 또는 메시지 키가 있는 경우에만 인식합니다.
+type LogfmtParser struct{}
+
+// Parse는 logfmt 라인에서 레벨, 메시지, 나머지 필드를 추출합니다
+func (LogfmtParser) Parse(line string) (Result, bool) {
+	fields, ok := parseLogfmt(line)
+	if !ok || len(fields) < 2 {
+		return Result{}, false
+	}
+	if firstField(fields, levelKeys) == "" && firstField(fields, messageKeys) == "" {
+		return Result{}, false
+	}
+
+	return structuredResult(fields), true
+}
+
+// parseLogfmt는 key=value 쌍을 분석합니다. 값은 따옴표로 감쌀 수 있습니다.
+func parseLogfmt(line string) (map[string]string, bool) {
+	fields := make(map[string]string)
+	rest := strings.TrimSpace(line)
+
+	for rest != "" {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return nil, false
+		}
+		key := rest[:eq]
+		if strings.ContainsAny(key, " \t\"") {
+			return nil, false
+		}
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := 1
+			for end < len(rest) && (rest[end] != '"' || rest[end-1] == '\\') {
+				end++
+			}
+			if end >= len(rest) {
+				return nil, false
+			}
+			value = strings.ReplaceAll(rest[1:end], `\"`, `"`)
+			rest = rest[end+1:]
+		} else if space := strings.IndexAny(rest, " \t"); space >= 0 {
+			value, rest = rest[:space], rest[space:]
+		} else {
+			value, rest = rest, ""
+		}
+
+		fields[key] = value
+		rest = strings.TrimLeft(rest, " \t")
+	}
+
+	return fields, len(fields) > 0
+}
+
+// structuredResult는 구조화 로그 필드에서 레벨/메시지를 분리합니다
+func structuredResult(fields map[string]string) Result {
+	result := Result{Fields: fields}
+
+	for _, key := range levelKeys {
+		if value, exists := fields[key]; exists {
+			result.Level = NormalizeLevel(value)
+			delete(fields, key)
+			break
+		}
+	}
+	for _, key := range messageKeys {
+		if value, exists := fields[key]; exists {
+			result.Message = value
+			delete(fields, key)
+			break
+		}
+	}
+
+	return result
+}
+
+// firstField는 keys 중 처음으로 존재하는 필드의 값을 반환합니다
+func firstField(fields map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value := fields[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+var (
+	// 대괄호/콜론으로 표시된 레벨은 대소문자 구분 없이 인식 (예: "[error] ...", "warn: ...")
+	markedLevelPattern = regexp.MustCompile(`(?i)^(?:\S*\d\S*\s+){0,2}(?:\[(trace|debug|info|notice|warn|warning|error|err|fatal|panic|critical|crit)\]|(trace|debug|info|notice|warn|warning|error|err|fatal|panic|critical|crit):)(?:\s|$)`)
+	// 표시 없는 레벨은 대문자만 인식 (예: "2024-01-01 12:00:00 ERROR ...")
+	bareLevelPattern = regexp.MustCompile(`^(?:\S*\d\S*\s+){0,2}(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERROR|ERR|FATAL|PANIC|CRITICAL|CRIT)(?:\s|$)`)
+)
+
+// LevelPrefixParser detects common level prefixes such as "ERROR", "WARN:" or "[error]".
+//
+// LevelPrefixParser는 "ERROR", "WARN:", "[error]" 같은 일반적인 레벨 접두사를 감지합니다.
+// 앞에 타임스탬프가 최대 두 토큰까지 있어도 인식합니다. 메시지는 변경하지 않습니다.
+type LevelPrefixParser struct{}
+
+// Parse는 라인 앞부분의 레벨 표시를 찾습니다
+func (LevelPrefixParser) Parse(line string) (Result, bool) {
+	trimmed := strings.TrimSpace(line)
+
+	if match := markedLevelPattern.FindStringSubmatch(trimmed); match != nil {
+		return Result{Level: NormalizeLevel(match[1] + match[2])}, true
+	}
+	if match := bareLevelPattern.FindStringSubmatch(trimmed); match != nil {
+		return Result{Level: NormalizeLevel(match[1])}, true
+	}
+	return Result{}, false
+}
+
+// NormalizeLevel maps a level name to DEBUG, INFO, WARN or ERROR.
+//
+// NormalizeLevel은 다양한 레벨 표기를 DEBUG, INFO, WARN, ERROR 중 하나로 변환합니다.
+// 알 수 없는 값이면 빈 문자열을 반환합니다.
+func NormalizeLevel(level string) string {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "trace", "debug", "dbg", "10", "20": // 숫자 레벨은 pino/bunyan 규칙
+		return LevelDebug
+	case "info", "information", "notice", "30":
+		return LevelInfo
+	case "warn", "warning", "40":
+		return LevelWarn
+	case "error", "err", "fatal", "panic", "dpanic", "critical", "crit", "alert", "emergency", "50", "60":
+		return LevelError
+	default:
+		return ""
+	}
+}
+
+// LevelRank orders normalized levels for minimum-level filters.
+//
+// LevelRank는 최소 레벨 필터링을 위한 순위를 반환합니다 (DEBUG와 알 수 없는 레벨은 0).
+func LevelRank(level string) int {
+	switch level {
+	case LevelInfo:
+		return 1
+	case LevelWarn:
+		return 2
+	case LevelError:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Parse runs the parser chain over a line and returns the first match.
+//
+// Parse는 Parser 체인을 순서대로 실행하여 처음 인식한 결과를 반환합니다.
+func Parse(parsers []Parser, line string) (Result, bool) {
+	for _, parser := range parsers {
+		if result, ok := parser.Parse(line); ok {
+			return result, true
+		}
+	}
+	return Result{}, false
+}
@@ -0,0 +1,215 @@
+package logs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// RedactedText는 등록된 Secret 값을 대체하는 문자열입니다
+	RedactedText = "[REDACTED]"
+
+	// minSecretLength보다 짧은 Secret 값은 일반 단어까지 가릴 수 있어 마스킹하지 않습니다
+	minSecretLength = 4
+)
+
+var (
+	privateKeyBegin = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+	privateKeyEnd   = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+)
+
+// RedactionPattern masks every match of a regular expression.
+//
+// RedactionPattern은 정규식과 일치하는 부분을 "[REDACTED:<Name>]"으로 마스킹합니다.
+// 정규식에 캡처 그룹이 있으면 첫 번째 그룹만 마스킹하여 키 이름 등 주변 문맥을 남깁니다.
+type RedactionPattern struct {
+	Name   string
+	Regexp *regexp.Regexp
+}
+
+// DefaultRedactionPatterns returns patterns for common credentials.
+//
+// DefaultRedactionPatterns는 자주 유출되는 자격 증명 패턴을 반환합니다.
+//   - aws_access_key: AWS Access Key ID (AKIA..., ASIA...)
+//   - aws_secret_key: aws_secret_access_key=... 형태의 Secret Access Key
+//   - jwt: JSON Web Token (eyJ...eyJ...서명)
+//   - private_key: 한 엔트리 안의 PEM 개인 키 블록
+func DefaultRedactionPatterns() []RedactionPattern {
+	return []RedactionPattern{
+		{Name: "aws_access_key", Regexp: regexp.MustCompile(`\b((?:AKIA|ASIA|AIDA|AROA)[0-9A-Z]{16})\b`)},
+		{Name: "aws_secret_key", Regexp: regexp.MustCompile(`(?i)aws_?secret_?(?:access_?)?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`)},
+		{Name: "jwt", Regexp: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]+`)},
+		{Name: "private_key", Regexp: regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----[\s\S]*?-----END [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)},
+	}
+}
+
+// CompileRedactionPatterns builds the pattern list from configuration.
+//
+// CompileRedactionPatterns는 기본 패턴에 설정의 패턴(이름 → 정규식)을 더합니다.
+// 기본 패턴과 이름이 같으면 설정이 우선하고, 정규식이 비어있으면 해당 기본 패턴을 끕니다.
+func CompileRedactionPatterns(overrides map[string]string) ([]RedactionPattern, error) {
+	patterns := DefaultRedactionPatterns()
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expr := overrides[name]
+
+		kept := patterns[:0]
+		for _, pattern := range patterns {
+			if pattern.Name != name {
+				kept = append(kept, pattern)
+			}
+		}
+		patterns = kept
+
+		if expr == "" {
+			continue
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %s: %w", name, err)
+		}
+		patterns = append(patterns, RedactionPattern{Name: name, Regexp: re})
+	}
+
+	return patterns, nil
+}
+
+// Redactor masks secret values and credential patterns in log text.
+//
+// Redactor는 로그 텍스트에서 등록된 Secret 값과 자격 증명 패턴을 마스킹합니다.
+// Secret 값은 원본뿐 아니라 base64, URL 인코딩된 형태도 함께 마스킹합니다.
+// Redactor는 불변이므로 여러 고루틴에서 공유할 수 있습니다.
+type Redactor struct {
+	secrets  []string // 길이가 긴 것부터 정렬 (부분 문자열이 먼저 치환되지 않도록)
+	patterns []RedactionPattern
+}
+
+// NewRedactor creates a redactor for the given secret values and patterns.
+//
+// NewRedactor는 Secret 값과 패턴으로 Redactor를 생성합니다.
+func NewRedactor(secrets []string, patterns []RedactionPattern) *Redactor {
+	seen := make(map[string]bool)
+	var variants []string
+	for _, secret := range secrets {
+		for _, variant := range secretVariants(secret) {
+			if len(variant) < minSecretLength || seen[variant] {
+				continue
+			}
+			seen[variant] = true
+			variants = append(variants, variant)
+		}
+	}
+
+	sort.Slice(variants, func(i, j int) bool {
+		return len(variants[i]) > len(variants[j])
+	})
+
+	return &Redactor{secrets: variants, patterns: patterns}
+}
+
+// secretVariants는 Secret 값과 로그에 나타날 수 있는 인코딩된 형태를 반환합니다
+func secretVariants(secret string) []string {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil
+	}
+
+	data := []byte(secret)
+	return []string{
+		secret,
+		base64.StdEncoding.EncodeToString(data),
+		base64.RawStdEncoding.EncodeToString(data),
+		base64.URLEncoding.EncodeToString(data),
+		base64.RawURLEncoding.EncodeToString(data),
+		url.QueryEscape(secret),
+		url.PathEscape(secret),
+	}
+}
+
+// Redact masks secrets and patterns in text and returns the number of masked values.
+//
+// Redact는 text에서 Secret 값과 패턴을 마스킹하고 마스킹한 개수를 반환합니다.
+func (r *Redactor) Redact(text string) (string, int) {
+	if r == nil || text == "" {
+		return text, 0
+	}
+
+	count := 0
+	for _, secret := range r.secrets {
+		if n := strings.Count(text, secret); n > 0 {
+			text = strings.ReplaceAll(text, secret, RedactedText)
+			count += n
+		}
+	}
+
+	for _, pattern := range r.patterns {
+		replacement := "[REDACTED:" + pattern.Name + "]"
+		text = pattern.Regexp.ReplaceAllStringFunc(text, func(match string) string {
+			count++
+			groups := pattern.Regexp.FindStringSubmatchIndex(match)
+			if len(groups) >= 4 && groups[2] >= 0 {
+				return match[:groups[2]] + replacement + match[groups[3]:]
+			}
+			return replacement
+		})
+	}
+
+	return text, count
+}
+
+// Stream returns a per-stream redactor that also masks multi-line private keys.
+//
+// Stream은 로그 스트림(컨테이너) 하나에 사용할 RedactStream을 생성합니다.
+func (r *Redactor) Stream() *RedactStream {
+	if r == nil {
+		return nil
+	}
+	return &RedactStream{redactor: r}
+}
+
+// RedactStream masks secrets across consecutive lines of one log stream.
+//
+// RedactStream은 Redactor에 더해 여러 라인에 걸쳐 출력된 PEM 개인 키 블록을
+// BEGIN부터 END 라인까지 마스킹합니다. 동시 호출에 안전하지 않습니다.
+type RedactStream struct {
+	redactor *Redactor
+	inKey    bool // 개인 키 블록 안에 있는지 여부
+}
+
+// Redact는 라인(또는 묶인 엔트리)을 마스킹하고 마스킹한 개수를 반환합니다
+func (s *RedactStream) Redact(text string) (string, int) {
+	if s == nil {
+		return text, 0
+	}
+
+	text, count := s.redactor.Redact(text)
+
+	if s.inKey {
+		end := privateKeyEnd.FindStringIndex(text)
+		if end == nil {
+			return "[REDACTED:private_key]", count + 1
+		}
+		s.inKey = false
+		text = "[REDACTED:private_key]" + text[end[1]:]
+		count++
+	}
+
+	// 같은 엔트리에서 끝나지 않은 개인 키 블록은 다음 라인부터 END까지 마스킹
+	if begin := privateKeyBegin.FindStringIndex(text); begin != nil {
+		s.inKey = true
+		text = text[:begin[0]] + "[REDACTED:private_key]"
+		count++
+	}
+
+	return text, count
+}
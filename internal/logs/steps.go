@@ -0,0 +1,254 @@
+package logs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSteps는 컨테이너 하나에서 요약을 보관하는 최대 Step 수입니다 (넘으면 오래된 Step부터 버림)
+	MaxSteps = 1000
+	// MaxStepNameBytes는 Step 이름의 최대 길이입니다
+	MaxStepNameBytes = 200
+)
+
+// StepStatus is the state of a step recognized in worker output.
+//
+// StepStatus는 Worker 출력에서 인식한 Step의 상태입니다.
+type StepStatus string
+
+const (
+	// StepRunning은 아직 끝나지 않은 Step입니다
+	StepRunning StepStatus = "running"
+	// StepSucceeded는 종료 코드 0으로 끝난 Step입니다
+	StepSucceeded StepStatus = "succeeded"
+	// StepFailed는 0이 아닌 종료 코드로 끝난 Step입니다
+	StepFailed StepStatus = "failed"
+	// StepUnknown은 결과를 알 수 없이 수집이 끝난 Step입니다
+	StepUnknown StepStatus = "unknown"
+)
+
+// StepEvent tells whether a log line opened or closed a step.
+//
+// StepEvent는 로그 라인이 Step을 시작했는지 끝냈는지를 나타냅니다.
+type StepEvent string
+
+const (
+	// StepStart는 Step을 시작한 Marker 라인입니다
+	StepStart StepEvent = "start"
+	// StepEnd는 Step을 끝낸 Marker 라인입니다
+	StepEnd StepEvent = "end"
+)
+
+// Step summarizes one named step of a container's output.
+//
+// Step은 컨테이너 출력의 이름 있는 Step 하나의 요약입니다.
+type Step struct {
+	Name        string
+	Status      StepStatus
+	ExitCode    int       // Status가 succeeded 또는 failed일 때만 의미 있음
+	StartedAt   time.Time // 시작 Marker 로그의 타임스탬프
+	CompletedAt time.Time // 실행 중이면 zero
+}
+
+// Duration returns how long the step ran, up to now while it is running.
+//
+// Duration은 Step의 실행 시간을 반환합니다 (실행 중이면 지금까지의 시간).
+func (s Step) Duration() time.Duration {
+	end := s.CompletedAt
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if end.Before(s.StartedAt) {
+		return 0
+	}
+	return end.Sub(s.StartedAt)
+}
+
+// StepMark is the step a log line belongs to.
+//
+// StepMark는 로그 라인이 속한 Step과, Marker 라인이면 그 이벤트입니다.
+// 끝 Marker의 Step에는 완료 시간과 결과가 채워져 있습니다.
+type StepMark struct {
+	Step  Step
+	Event StepEvent // 일반 라인이면 빈 값
+}
+
+// StepTracker recognizes step markers in one container's output.
+//
+// StepTracker는 컨테이너 하나의 출력에서 Step Marker를 인식하여 Step을 추적합니다.
+// 인식하는 Marker (앞뒤 공백과 ANSI 시퀀스는 무시):
+//
+//	::group::<이름>      Step 시작
+//	##[group]<이름>      Step 시작
+//	##[step] <이름>      Step 시작
+//	::endgroup::         Step 끝
+//	##[endgroup]         Step 끝
+//	##[endstep]          Step 끝
+//
+// 끝 Marker 뒤에 exit=<코드>를 붙이면 (예: "::endgroup:: exit=1") 그 코드로 결과가
+// 정해지고, 없으면 성공으로 봅니다. Step은 중첩되지 않으므로 열린 Step이 있는데
+// 새 Step이 시작되면 이전 Step은 성공으로 끝납니다. 컨테이너가 끝날 때 열려 있던
+// Step은 Finish로 컨테이너 종료 코드를 받습니다.
+//
+// Observe는 한 고루틴에서 호출되며, Steps는 다른 고루틴에서 동시에 호출할 수 있습니다.
+type StepTracker struct {
+	mu      sync.Mutex
+	steps   []Step
+	current int // 열린 Step의 steps 인덱스 (-1이면 없음)
+}
+
+// NewStepTracker creates an empty step tracker.
+//
+// NewStepTracker는 빈 StepTracker를 생성합니다.
+func NewStepTracker() *StepTracker {
+	return &StepTracker{current: -1}
+}
+
+// Observe processes a log line and returns the step it belongs to.
+//
+// Observe는 로그 라인 하나를 처리하고 라인이 속한 Step을 반환합니다.
+// 어떤 Step에도 속하지 않으면 false를 반환합니다. Marker 라인은 자신이 시작하거나
+// 끝낸 Step에 속합니다.
+func (t *StepTracker) Observe(message string, at time.Time) (StepMark, bool) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	event, name, exitCode := parseStepMarker(message)
+	switch event {
+	case StepStart:
+		t.close(at, 0)
+		if name == "" {
+			name = fmt.Sprintf("step %d", len(t.steps)+1)
+		}
+		t.steps = append(t.steps, Step{Name: name, Status: StepRunning, StartedAt: at})
+		if len(t.steps) > MaxSteps {
+			t.steps = append(t.steps[:0:0], t.steps[len(t.steps)-MaxSteps:]...)
+		}
+		t.current = len(t.steps) - 1
+		return StepMark{Step: t.steps[t.current], Event: StepStart}, true
+
+	case StepEnd:
+		step, ok := t.close(at, exitCode)
+		if !ok {
+			return StepMark{}, false
+		}
+		return StepMark{Step: step, Event: StepEnd}, true
+
+	default:
+		if t.current < 0 {
+			return StepMark{}, false
+		}
+		return StepMark{Step: t.steps[t.current]}, true
+	}
+}
+
+// Finish closes the open step with the container's exit code.
+//
+// Finish는 컨테이너가 끝났을 때 열려 있는 Step을 컨테이너 종료 코드로 끝냅니다.
+func (t *StepTracker) Finish(exitCode int, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.close(at, exitCode)
+}
+
+// Abandon marks the open step as unknown when collection stops early.
+//
+// Abandon은 컨테이너 결과를 알 수 없이 수집이 끝났을 때 열린 Step을 unknown으로 끝냅니다.
+func (t *StepTracker) Abandon(at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.current < 0 {
+		return
+	}
+	step := &t.steps[t.current]
+	step.Status = StepUnknown
+	step.CompletedAt = at
+	t.current = -1
+}
+
+// Steps returns a snapshot of the tracked steps in start order.
+//
+// Steps는 추적 중인 Step들을 시작 순서대로 복사해 반환합니다.
+func (t *StepTracker) Steps() []Step {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Step(nil), t.steps...)
+}
+
+// close는 열린 Step을 종료 코드로 끝내고 반환합니다 (열린 Step이 없으면 false)
+func (t *StepTracker) close(at time.Time, exitCode int) (Step, bool) {
+	if t.current < 0 {
+		return Step{}, false
+	}
+
+	step := &t.steps[t.current]
+	step.ExitCode = exitCode
+	step.Status = StepSucceeded
+	if exitCode != 0 {
+		step.Status = StepFailed
+	}
+	step.CompletedAt = at
+	if step.CompletedAt.Before(step.StartedAt) {
+		step.CompletedAt = step.StartedAt
+	}
+	t.current = -1
+	return *step, true
+}
+
+// parseStepMarker는 Step Marker 라인에서 이벤트, Step 이름, 종료 코드를 추출합니다
+// (Marker가 아니면 빈 이벤트)
+func parseStepMarker(message string) (StepEvent, string, int) {
+	if !strings.Contains(message, "::") && !strings.Contains(message, "##[") {
+		return "", "", 0
+	}
+	line := strings.TrimSpace(StripANSI(message))
+
+	for _, prefix := range []string{"::group::", "##[group]", "##[step]"} {
+		if name, ok := strings.CutPrefix(line, prefix); ok {
+			return StepStart, stepName(name), 0
+		}
+	}
+	for _, prefix := range []string{"::endgroup::", "##[endgroup]", "##[endstep]"} {
+		if rest, ok := strings.CutPrefix(line, prefix); ok {
+			return StepEnd, "", stepExitCode(rest)
+		}
+	}
+	return "", "", 0
+}
+
+// stepName은 Step 이름을 정리하고 MaxStepNameBytes로 자릅니다 (UTF-8 문자 경계 유지)
+func stepName(name string) string {
+	name = strings.TrimSpace(name)
+	if len(name) <= MaxStepNameBytes {
+		return name
+	}
+	cut := MaxStepNameBytes
+	for cut > 0 && !utf8.RuneStart(name[cut]) {
+		cut--
+	}
+	return name[:cut]
+}
+
+// stepExitCode는 끝 Marker 뒤의 exit=<코드>를 읽습니다 (없거나 잘못되면 0)
+func stepExitCode(rest string) int {
+	for _, field := range strings.Fields(rest) {
+		value, ok := strings.CutPrefix(field, "exit=")
+		if !ok {
+			continue
+		}
+		if code, err := strconv.Atoi(value); err == nil {
+			return code
+		}
+	}
+	return 0
+}
@@ -27,6 +27,7 @@ type Executor struct {
 
 	// Pipeline 실행 상태
 	pipeline       *pb.PipelineRequest
+	owner          *worker.RunOwner // 모든 Worker Pod의 부모 오브젝트
 	stages         map[string]*StageInfo
 	stageOrder     [][]string // 실행 순서 (각 레벨은 병렬 실행 가능)
 	progressStream chan *pb.PipelineProgress
@@ -82,6 +83,17 @@ func (e *Executor) Execute(ctx context.Context, req *pb.PipelineRequest) (<-chan
 	if err := e.parseStages(); err != nil {
 		return nil, fmt.Errorf("pipeline 파싱 실패: %w", err)
 	}
+
+	// Create or bump the pipeline's parent object (owner of all its worker pods)
+	owner, err := e.workerManager.EnsureRunOwner(ctx, worker.OwnerKindPipeline, req.PipelineId, map[string]string{
+		"name":       req.Name,
+		"repository": req.Repository,
+		"stages":     fmt.Sprint(len(req.Stages)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pipeline 부모 오브젝트 생성 실패: %w", err)
+	}
+	e.owner = owner
 	
 	// Start execution in background
 	go e.executePipeline(execCtx)
@@ -304,9 +316,14 @@ func (e *Executor) createWorkerConfigs(stage *pb.PipelineStage) []worker.WorkerC
 		image = "busybox:latest" // TODO: Get from config
 	}
 	
+	// Stage 재시도 시 이전 시도의 Pod와 이름이 겹치지 않도록 base에 재시도 횟수 포함
+	base := fmt.Sprintf("otto-%s-%s", e.pipeline.PipelineId, stage.StageId)
+	if retryCount := e.stages[stage.StageId].RetryCount; retryCount > 0 {
+		base = fmt.Sprintf("%s-retry%d", base, retryCount)
+	}
+	
 	for i := int32(0); i < stage.WorkerCount; i++ {
-		workerID := fmt.Sprintf("otto-%s-%s-%d",
-			e.pipeline.PipelineId, stage.StageId, i+1)
+		workerID := worker.WorkerPodName(base, e.owner.Attempt, int(i+1))
 		
 		configs[i] = worker.WorkerConfig{
 			Name:    workerID,
@@ -319,6 +336,12 @@ func (e *Executor) createWorkerConfigs(stage *pb.PipelineStage) []worker.WorkerC
 				"stage-type":  stage.Type,
 				"managed-by":  "ottoscaler",
 			},
+			Services: convertServices(stage.Services),
+			Cache: &worker.CacheRequest{
+				Repository: e.pipeline.Repository,
+				Key:        stage.Config["cache_key"],
+			},
+			Owner: e.owner,
 		}
 		
 		// Store worker pod name
@@ -331,6 +354,28 @@ func (e *Executor) createWorkerConfigs(stage *pb.PipelineStage) []worker.WorkerC
 	return configs
 }
 
+// convertServices는 Stage의 서비스 컨테이너 정의를 Worker 설정으로 변환합니다.
+func convertServices(services []*pb.ServiceContainer) []worker.ServiceConfig {
+	if len(services) == 0 {
+		return nil
+	}
+
+	configs := make([]worker.ServiceConfig, len(services))
+	for i, service := range services {
+		configs[i] = worker.ServiceConfig{
+			Name:             service.Name,
+			Image:            service.Image,
+			Env:              service.Env,
+			Ports:            service.Ports,
+			ReadinessCommand: service.ReadinessCommand,
+			Command:          service.Command,
+			Args:             service.Args,
+		}
+	}
+
+	return configs
+}
+
 // shouldRetry는 Stage를 재시도해야 하는지 판단합니다.
 func (e *Executor) shouldRetry(stageInfo *StageInfo) bool {
 	if stageInfo.Stage.RetryPolicy == nil {
@@ -413,6 +458,12 @@ func (e *Executor) sendStageProgress(stageID string, status pb.StageStatus, mess
 	stageInfo := e.stages[stageID]
 	e.mu.RUnlock()
 	
+	// Job 백엔드에서는 Worker 설정 이름 대신 Job 컨트롤러가 만든 실제 Pod 이름으로 보고
+	podNames := make([]string, len(stageInfo.WorkerPodNames))
+	for i, name := range stageInfo.WorkerPodNames {
+		podNames[i] = e.workerManager.PodName(name)
+	}
+	
 	progress := &pb.PipelineProgress{
 		PipelineId:         e.pipeline.PipelineId,
 		StageId:            stageID,
@@ -420,10 +471,15 @@ func (e *Executor) sendStageProgress(stageID string, status pb.StageStatus, mess
 		Message:            message,
 		ProgressPercentage: percentage,
 		Timestamp:          time.Now().Format(time.RFC3339),
-		WorkerPodNames:     stageInfo.WorkerPodNames,
+		WorkerPodNames:     podNames,
 		Metrics:            stageInfo.Metrics,
 	}
 	
+	// Worker 로그의 Step Marker로 인식한 Step 요약
+	for _, podName := range podNames {
+		progress.Steps = append(progress.Steps, e.workerManager.WorkerSteps(podName)...)
+	}
+	
 	if !stageInfo.StartTime.IsZero() {
 		progress.StartedAt = stageInfo.StartTime.Format(time.RFC3339)
 	}
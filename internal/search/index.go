@@ -0,0 +1,410 @@
+// Package search provides a local full-text index of collected worker logs.
+//
+// 이 패키지는 수집한 Worker 로그를 로컬 디스크의 세그먼트 파일에 기록하면서
+// 세그먼트별 역색인(토큰 → 라인)을 함께 만들어, 여러 Worker의 로그를 매번
+// 전체 스캔하지 않고 검색할 수 있게 합니다.
+//
+// 동작 방식:
+//   - Writer는 Worker별로 엔트리를 기록하고 라인마다 토큰(영숫자와 '_'의 연속, 소문자)을 색인
+//   - 세그먼트가 가득 차거나 Writer가 닫히면 역색인을 .idx 파일로 저장하고 봉인
+//   - Search는 세그먼트의 시간 범위와 최고 레벨로 먼저 거르고, 검색어의 토큰으로 후보 라인을
+//     찾은 뒤 실제 내용으로 다시 확인
+//   - 보관 기간(retention)이 지난 Worker 로그는 삭제
+//
+// 저장 구조:
+//
+//	<dir>/<task>/<worker>/worker.json      Worker 메타데이터와 봉인된 세그먼트 목록
+//	<dir>/<task>/<worker>/seg-<n>.jsonl    로그 엔트리 (한 줄에 하나)
+//	<dir>/<task>/<worker>/seg-<n>.idx      봉인된 세그먼트의 역색인과 라인 오프셋
+package search
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+)
+
+const (
+	// DefaultRetention은 Worker 로그를 색인에 보관하는 기본 기간입니다
+	DefaultRetention = 72 * time.Hour
+
+	// segmentMaxLines, segmentMaxBytes를 넘으면 세그먼트를 봉인하고 새로 시작합니다
+	segmentMaxLines = 8192
+	segmentMaxBytes = 4 << 20
+	// pruneInterval은 보관 기간이 지난 로그를 확인하는 최소 간격입니다
+	pruneInterval = 1 * time.Hour
+)
+
+// Entry is a single indexed log line.
+//
+// Entry는 색인된 로그 라인 하나입니다. Line은 Worker 로그 안의 라인 번호(0부터)입니다.
+type Entry struct {
+	Line      int64     `json:"line"`
+	Timestamp time.Time `json:"ts"`
+	Level     string    `json:"level"`
+	Source    string    `json:"source,omitempty"`
+	Message   string    `json:"msg"`
+}
+
+// WorkerInfo identifies whose log an index belongs to.
+//
+// WorkerInfo는 색인된 로그의 Worker와 Task, Pipeline, Stage입니다.
+type WorkerInfo struct {
+	WorkerID   string `json:"worker_id"`
+	TaskID     string `json:"task_id,omitempty"`
+	PipelineID string `json:"pipeline_id,omitempty"`
+	StageID    string `json:"stage_id,omitempty"`
+}
+
+// workerMeta는 worker.json에 저장되는 내용입니다
+type workerMeta struct {
+	WorkerInfo
+	UpdatedAt time.Time     `json:"updated_at"`
+	Segments  []segmentMeta `json:"segments"`
+}
+
+// Index is a per-worker inverted index of collected logs on local disk.
+//
+// Index는 Worker 로그와 역색인을 로컬 디스크에 관리합니다. 동시 호출에 안전합니다.
+type Index struct {
+	dir       string
+	retention time.Duration
+
+	mu        sync.Mutex
+	workers   map[string]*workerLog // 키: <task>/<worker>
+	lastPrune time.Time
+}
+
+// workerLog는 Worker 하나의 색인 상태입니다
+type workerLog struct {
+	key string
+	dir string
+
+	mu      sync.Mutex
+	meta    workerMeta
+	active  *segment // 기록 중인 세그먼트 (nil이면 다음 기록 때 새로 시작)
+	writers int      // 열려 있는 Writer 수
+	removed bool     // 보관 기간이 지나 삭제됨
+}
+
+// Open loads the index under dir and removes logs older than retention.
+//
+// Open은 dir의 색인을 불러옵니다. 재시작 전에 봉인되지 않은 세그먼트는 다시 색인하고,
+// 보관 기간이 지난 Worker 로그는 삭제합니다. retention이 0 이하면 DefaultRetention을 사용합니다.
+func Open(dir string, retention time.Duration) (*Index, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("search index directory is required")
+	}
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create search index directory %s: %w", dir, err)
+	}
+
+	index := &Index{
+		dir:       dir,
+		retention: retention,
+		workers:   make(map[string]*workerLog),
+	}
+
+	metaFiles, err := filepath.Glob(filepath.Join(dir, "*", "*", "worker.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, metaFile := range metaFiles {
+		workerDir := filepath.Dir(metaFile)
+		key := filepath.ToSlash(filepath.Join(filepath.Base(filepath.Dir(workerDir)), filepath.Base(workerDir)))
+
+		wl, err := loadWorkerLog(key, workerDir)
+		if err != nil {
+			log.Printf("⚠️ 검색 색인을 불러오지 못해 건너뜁니다 (%s): %v", workerDir, err)
+			continue
+		}
+		index.workers[key] = wl
+	}
+
+	index.prune(time.Now())
+	return index, nil
+}
+
+// NewWriter opens the worker's log for appending.
+//
+// NewWriter는 Worker 로그에 엔트리를 추가할 Writer를 반환합니다.
+// 이미 색인된 Worker(재시작 후 수집 재개 등)면 라인 번호를 이어서 기록합니다.
+func (x *Index) NewWriter(info WorkerInfo) (*Writer, error) {
+	if info.WorkerID == "" {
+		return nil, fmt.Errorf("worker id is required")
+	}
+
+	now := time.Now()
+	key := workerKey(info.TaskID, info.WorkerID)
+
+	x.mu.Lock()
+	if now.Sub(x.lastPrune) >= pruneInterval {
+		x.prune(now)
+	}
+	wl, exists := x.workers[key]
+	if !exists {
+		wl = &workerLog{
+			key:  key,
+			dir:  filepath.Join(x.dir, filepath.FromSlash(key)),
+			meta: workerMeta{WorkerInfo: info, UpdatedAt: now},
+		}
+		x.workers[key] = wl
+	}
+	x.mu.Unlock()
+
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	if info.PipelineID != "" {
+		wl.meta.PipelineID, wl.meta.StageID = info.PipelineID, info.StageID
+	}
+	if err := os.MkdirAll(wl.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create search index for %s: %w", info.WorkerID, err)
+	}
+	if err := wl.saveMeta(); err != nil {
+		return nil, err
+	}
+	wl.writers++
+	return &Writer{log: wl}, nil
+}
+
+// prune은 보관 기간이 지난 Worker 로그를 삭제합니다 (x.mu를 잡은 상태에서 호출)
+func (x *Index) prune(now time.Time) {
+	x.lastPrune = now
+
+	for key, wl := range x.workers {
+		wl.mu.Lock()
+		expired := wl.writers == 0 && now.Sub(wl.meta.UpdatedAt) > x.retention
+		if expired {
+			wl.removed = true
+			if err := os.RemoveAll(wl.dir); err != nil {
+				log.Printf("⚠️ 검색 색인 삭제 실패 (%s): %v", wl.dir, err)
+			}
+			os.Remove(filepath.Dir(wl.dir)) // 비어있으면 Task 디렉토리도 삭제
+		}
+		wl.mu.Unlock()
+
+		if expired {
+			delete(x.workers, key)
+		}
+	}
+}
+
+// Writer appends one worker's entries to the index.
+//
+// Writer는 Worker 하나의 로그를 색인에 기록합니다. 여러 컨테이너의 로그를
+// 동시에 기록할 수 있도록 동시 호출에 안전합니다.
+type Writer struct {
+	log    *workerLog
+	closed bool
+}
+
+// Write는 엔트리에 라인 번호를 붙여 기록하고 색인합니다
+func (w *Writer) Write(entry Entry) error {
+	wl := w.log
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	if w.closed {
+		return fmt.Errorf("search index writer for %s is closed", wl.meta.WorkerID)
+	}
+	if wl.removed {
+		return nil
+	}
+
+	if wl.active == nil {
+		active, err := createSegment(wl.dir, wl.nextSegmentMeta())
+		if err != nil {
+			return err
+		}
+		wl.active = active
+	}
+
+	entry.Line = wl.active.meta.FirstLine + int64(wl.active.meta.Lines)
+	if err := wl.active.append(entry); err != nil {
+		return err
+	}
+	wl.meta.UpdatedAt = time.Now()
+
+	if wl.active.meta.Lines >= segmentMaxLines || wl.active.meta.Size >= segmentMaxBytes {
+		return wl.sealActive()
+	}
+	return nil
+}
+
+// Close는 마지막 Writer가 닫히면 기록 중인 세그먼트를 봉인합니다
+func (w *Writer) Close() error {
+	wl := w.log
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	wl.writers--
+
+	if wl.writers > 0 || wl.removed {
+		return nil
+	}
+	return wl.sealActive()
+}
+
+// loadWorkerLog는 worker.json을 읽고 봉인되지 않은 세그먼트를 다시 색인합니다
+func loadWorkerLog(key, dir string) (*workerLog, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "worker.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	wl := &workerLog{key: key, dir: dir}
+	if err := json.Unmarshal(data, &wl.meta); err != nil {
+		return nil, fmt.Errorf("invalid worker.json: %w", err)
+	}
+
+	// 마지막 봉인 이후의 세그먼트 파일은 재시작 전에 기록 중이던 것
+	for {
+		next := wl.nextSegmentMeta()
+		path := segmentPath(dir, next.Seq)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+
+		recovered, err := recoverSegment(path, next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover %s: %w", path, err)
+		}
+		wl.active = recovered
+		if err := wl.sealActive(); err != nil {
+			return nil, err
+		}
+		log.Printf("🔎 봉인되지 않은 검색 세그먼트 복구: %s (%d줄)", path, recovered.meta.Lines)
+	}
+	return wl, nil
+}
+
+// nextSegmentMeta는 다음 세그먼트의 번호와 시작 라인을 반환합니다
+func (wl *workerLog) nextSegmentMeta() segmentMeta {
+	if n := len(wl.meta.Segments); n > 0 {
+		last := wl.meta.Segments[n-1]
+		return segmentMeta{Seq: last.Seq + 1, FirstLine: last.FirstLine + int64(last.Lines)}
+	}
+	return segmentMeta{Seq: 1}
+}
+
+// sealActive는 기록 중인 세그먼트의 역색인을 저장하고 목록에 추가합니다 (wl.mu를 잡은 상태)
+func (wl *workerLog) sealActive() error {
+	active := wl.active
+	if active == nil {
+		return nil
+	}
+	wl.active = nil
+
+	if err := active.seal(wl.dir); err != nil {
+		return err
+	}
+	if active.meta.Lines == 0 {
+		os.Remove(segmentPath(wl.dir, active.meta.Seq))
+		return nil
+	}
+	wl.meta.Segments = append(wl.meta.Segments, active.meta)
+	return wl.saveMeta()
+}
+
+// saveMeta는 worker.json을 임시 파일에 쓴 뒤 rename합니다 (wl.mu를 잡은 상태)
+func (wl *workerLog) saveMeta() error {
+	data, err := json.Marshal(wl.meta)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(wl.dir, "worker.json"), data)
+}
+
+// snapshot은 검색에 사용할 Worker 정보와 봉인된 세그먼트 목록을 복사합니다.
+// 기록 중인 세그먼트는 현재까지의 내용으로 고정하고 m의 후보 라인을 미리 찾습니다.
+func (wl *workerLog) snapshot(m *matcher) (WorkerInfo, []segmentMeta, *segmentView) {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	segments := append([]segmentMeta(nil), wl.meta.Segments...)
+	var active *segmentView
+	if wl.active != nil && wl.active.meta.Lines > 0 {
+		active = wl.active.view(m)
+	}
+	return wl.meta.WorkerInfo, segments, active
+}
+
+// workerKey는 Task와 Worker ID로 색인 디렉토리 키를 만듭니다
+func workerKey(taskID, workerID string) string {
+	if taskID == "" {
+		taskID = "_"
+	}
+	return escapePath(taskID) + "/" + escapePath(workerID)
+}
+
+// escapePath는 ID를 하나의 경로 요소로 안전하게 인코딩합니다
+func escapePath(id string) string {
+	escaped := url.PathEscape(id)
+	if escaped == "." || escaped == ".." {
+		escaped = strings.ReplaceAll(escaped, ".", "%2E")
+	}
+	return escaped
+}
+
+// writeFileAtomic은 임시 파일에 쓴 뒤 rename하여 파일을 원자적으로 교체합니다
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// levelRank는 세그먼트 필터링에 사용할 레벨 순위입니다
+func levelRank(level string) int {
+	return logs.LevelRank(logs.NormalizeLevel(level))
+}
+
+// readLines는 파일의 줄을 순서대로 읽습니다 (마지막 줄이 잘렸으면 그 앞까지)
+func readLines(r io.Reader, fn func(offset int64, line []byte) bool) error {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !fn(offset, line) {
+			return nil
+		}
+		offset += int64(len(line))
+	}
+}
+
+// sortedKeys는 Worker 키를 정렬합니다
+func sortedKeys(workers map[string]*workerLog) []string {
+	keys := make([]string, 0, len(workers))
+	for key := range workers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
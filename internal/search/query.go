@@ -0,0 +1,603 @@
+package search
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+)
+
+const (
+	// DefaultLimit은 한 페이지에 반환하는 기본 결과 수입니다
+	DefaultLimit = 100
+	// MaxLimit은 한 페이지에 반환하는 최대 결과 수입니다
+	MaxLimit = 1000
+	// MaxContext는 결과마다 앞뒤로 붙일 수 있는 최대 라인 수입니다
+	MaxContext = 20
+)
+
+// ErrInvalidQuery는 잘못된 정규식이나 페이지 토큰 등 요청 자체의 오류입니다
+var ErrInvalidQuery = errors.New("invalid search query")
+
+// Query describes a log search.
+//
+// Query는 로그 검색 조건입니다. 비어있는 조건은 적용하지 않습니다.
+type Query struct {
+	TaskID     string
+	PipelineID string
+	StageID    string
+	WorkerIDs  []string
+	Since      time.Time
+	Until      time.Time
+
+	Pattern    string // 부분 문자열 (Regex면 정규식), 비어있으면 모든 라인
+	Regex      bool
+	IgnoreCase bool
+	MinLevel   string // 이 레벨 이상만 (DEBUG < INFO < WARN < ERROR)
+
+	Context   int    // 결과마다 앞뒤로 붙일 라인 수
+	Limit     int    // 페이지 크기
+	PageToken string // 이전 결과의 NextPageToken
+}
+
+// Match is a matching log line with its surrounding lines.
+//
+// Match는 검색 결과 라인 하나와 앞뒤 문맥 라인입니다.
+type Match struct {
+	Worker WorkerInfo
+	Entry  Entry
+	Before []Entry
+	After  []Entry
+}
+
+// Result is one page of search results.
+//
+// Result는 검색 결과 한 페이지입니다. 결과는 Task, Worker, 라인 번호 순입니다.
+// NextPageToken이 비어있으면 마지막 페이지입니다.
+type Result struct {
+	Matches         []Match
+	NextPageToken   string
+	ScannedSegments int // 후보 라인을 실제로 읽은 세그먼트 수
+	SkippedSegments int // 시간 범위, 레벨, 역색인으로 건너뛴 세그먼트 수
+}
+
+// Search runs q against the index.
+//
+// Search는 조건에 맞는 Worker의 세그먼트를 요약(시간 범위, 최고 레벨)과 역색인으로 거른 뒤,
+// 후보 라인만 읽어 실제 내용으로 확인합니다. 기록 중인 로그도 검색 시점까지의 내용이 포함됩니다.
+func (x *Index) Search(ctx context.Context, q Query) (*Result, error) {
+	m, err := newMatcher(q)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodePageToken(q.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+	contextLines := min(max(q.Context, 0), MaxContext)
+
+	x.mu.Lock()
+	keys := sortedKeys(x.workers)
+	workers := make([]*workerLog, 0, len(keys))
+	for _, key := range keys {
+		if key >= cursor.key {
+			workers = append(workers, x.workers[key])
+		}
+	}
+	x.mu.Unlock()
+
+	result := &Result{}
+	lastKey := "" // 마지막 결과의 Worker 키
+	for _, wl := range workers {
+		info, segments, active := wl.snapshot(m)
+		if !q.matchesWorker(info) {
+			continue
+		}
+
+		after := int64(-1)
+		if wl.key == cursor.key {
+			after = cursor.line
+		}
+
+		matched := len(result.Matches)
+		r := newWorkerReader(wl.dir, m, segments, active)
+		done, err := r.search(ctx, info, after, contextLines, limit, result)
+		r.close()
+		if errors.Is(err, os.ErrNotExist) {
+			continue // 검색 중 보관 기간이 지나 삭제됨
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Matches) > matched {
+			lastKey = wl.key
+		}
+		if done {
+			last := result.Matches[len(result.Matches)-1]
+			result.NextPageToken = encodePageToken(lastKey, last.Entry.Line)
+			break
+		}
+	}
+	return result, nil
+}
+
+// matchesWorker는 Worker가 Task, Pipeline, Stage, Worker 조건에 맞는지 반환합니다
+func (q Query) matchesWorker(info WorkerInfo) bool {
+	if q.TaskID != "" && info.TaskID != q.TaskID {
+		return false
+	}
+	if q.PipelineID != "" && info.PipelineID != q.PipelineID {
+		return false
+	}
+	if q.StageID != "" && info.StageID != q.StageID {
+		return false
+	}
+	if len(q.WorkerIDs) == 0 {
+		return true
+	}
+	for _, workerID := range q.WorkerIDs {
+		if workerID == info.WorkerID {
+			return true
+		}
+	}
+	return false
+}
+
+// workerReader는 Worker 하나의 세그먼트를 읽습니다 (검색 한 번 동안 .idx와 파일을 캐시)
+type workerReader struct {
+	dir      string
+	matcher  *matcher
+	segments []segmentMeta // 봉인된 세그먼트 + 기록 중인 세그먼트
+	views    map[int]*segmentView
+	files    map[int]*os.File
+}
+
+// newWorkerReader는 snapshot 결과로 workerReader를 생성합니다
+func newWorkerReader(dir string, m *matcher, segments []segmentMeta, active *segmentView) *workerReader {
+	r := &workerReader{
+		dir:      dir,
+		matcher:  m,
+		segments: segments,
+		views:    make(map[int]*segmentView),
+		files:    make(map[int]*os.File),
+	}
+	if active != nil {
+		r.segments = append(r.segments, active.meta)
+		r.views[len(r.segments)-1] = active
+	}
+	return r
+}
+
+// search는 after 다음 라인부터 결과를 result에 추가합니다.
+// limit개가 찬 뒤에 결과가 더 있으면 true를 반환합니다.
+func (r *workerReader) search(ctx context.Context, info WorkerInfo, after int64, contextLines, limit int, result *Result) (bool, error) {
+	for i, meta := range r.segments {
+		if meta.FirstLine+int64(meta.Lines)-1 <= after {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if !r.matcher.mayMatch(meta) {
+			result.SkippedSegments++
+			continue
+		}
+
+		view, err := r.view(i)
+		if err != nil {
+			return false, err
+		}
+		if !view.all && len(view.candidates) == 0 {
+			result.SkippedSegments++
+			continue
+		}
+		result.ScannedSegments++
+
+		file, err := r.file(meta.Seq)
+		if err != nil {
+			return false, err
+		}
+
+		lines := view.candidates
+		if view.all {
+			lines = make([]uint32, meta.Lines)
+			for n := range lines {
+				lines[n] = uint32(n)
+			}
+		}
+		for _, n := range lines {
+			if meta.FirstLine+int64(n) <= after {
+				continue
+			}
+			entry, err := view.readEntry(file, int(n))
+			if err != nil {
+				return false, err
+			}
+			if !r.matcher.matches(entry) {
+				continue
+			}
+			if len(result.Matches) == limit {
+				return true, nil
+			}
+
+			match := Match{Worker: info, Entry: entry}
+			if contextLines > 0 {
+				if match.Before, err = r.lines(entry.Line-int64(contextLines), entry.Line-1); err != nil {
+					return false, err
+				}
+				if match.After, err = r.lines(entry.Line+1, entry.Line+int64(contextLines)); err != nil {
+					return false, err
+				}
+			}
+			result.Matches = append(result.Matches, match)
+		}
+	}
+	return false, nil
+}
+
+// lines는 from부터 to까지의 라인을 세그먼트 경계와 관계없이 읽습니다
+func (r *workerReader) lines(from, to int64) ([]Entry, error) {
+	var entries []Entry
+	for line := max(from, 0); line <= to; line++ {
+		i := sort.Search(len(r.segments), func(i int) bool {
+			return r.segments[i].FirstLine+int64(r.segments[i].Lines) > line
+		})
+		if i == len(r.segments) {
+			break
+		}
+
+		view, err := r.view(i)
+		if err != nil {
+			return nil, err
+		}
+		file, err := r.file(view.meta.Seq)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := view.readEntry(file, int(line-view.meta.FirstLine))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// view는 i번째 세그먼트의 색인을 읽습니다
+func (r *workerReader) view(i int) (*segmentView, error) {
+	if view, exists := r.views[i]; exists {
+		return view, nil
+	}
+	view, err := loadSegmentView(r.dir, r.segments[i], r.matcher)
+	if err != nil {
+		return nil, err
+	}
+	r.views[i] = view
+	return view, nil
+}
+
+// file은 세그먼트 파일을 엽니다
+func (r *workerReader) file(seq int) (*os.File, error) {
+	if file, exists := r.files[seq]; exists {
+		return file, nil
+	}
+	file, err := os.Open(segmentPath(r.dir, seq))
+	if err != nil {
+		return nil, err
+	}
+	r.files[seq] = file
+	return file, nil
+}
+
+// close는 열어둔 세그먼트 파일을 닫습니다
+func (r *workerReader) close() {
+	for _, file := range r.files {
+		file.Close()
+	}
+}
+
+// matcher는 컴파일된 검색 조건입니다
+type matcher struct {
+	query     Query
+	pattern   string         // 부분 문자열 검색어 (IgnoreCase면 소문자)
+	re        *regexp.Regexp // Regex일 때
+	fragments []fragment     // 일치하는 라인이 반드시 포함하는 토큰 조각
+	minLevel  int
+}
+
+// newMatcher는 검색어를 컴파일하고 역색인에서 찾을 토큰 조각을 추출합니다
+func newMatcher(q Query) (*matcher, error) {
+	m := &matcher{query: q}
+
+	if q.MinLevel != "" {
+		if logs.NormalizeLevel(q.MinLevel) == "" {
+			return nil, fmt.Errorf("%w: unknown level %q", ErrInvalidQuery, q.MinLevel)
+		}
+		m.minLevel = levelRank(q.MinLevel)
+	}
+	if !q.Since.IsZero() && !q.Until.IsZero() && q.Until.Before(q.Since) {
+		return nil, fmt.Errorf("%w: until is before since", ErrInvalidQuery)
+	}
+	if q.Pattern == "" {
+		return m, nil
+	}
+
+	var literals []string
+	if q.Regex {
+		expr := q.Pattern
+		if q.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+		}
+		m.re = re
+
+		parsed, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+		}
+		literals = requiredLiterals(parsed.Simplify())
+	} else {
+		m.pattern = q.Pattern
+		if q.IgnoreCase {
+			m.pattern = strings.ToLower(q.Pattern)
+		}
+		literals = []string{q.Pattern}
+	}
+
+	for _, literal := range literals {
+		m.fragments = append(m.fragments, literalFragments(literal)...)
+	}
+	return m, nil
+}
+
+// mayMatch는 세그먼트 요약만으로 일치하는 라인이 있을 수 있는지 반환합니다
+func (m *matcher) mayMatch(meta segmentMeta) bool {
+	if !m.query.Since.IsZero() && meta.MaxTime.Before(m.query.Since) {
+		return false
+	}
+	if !m.query.Until.IsZero() && meta.MinTime.After(m.query.Until) {
+		return false
+	}
+	return meta.MaxLevel >= m.minLevel
+}
+
+// candidates는 역색인에서 모든 토큰 조각을 포함하는 라인을 찾습니다.
+// 조각이 없으면(빈 검색어, 색인할 수 없는 짧은 검색어 등) 모든 라인이 후보입니다.
+func (m *matcher) candidates(tokens map[string][]uint32) ([]uint32, bool) {
+	if len(m.fragments) == 0 {
+		return nil, true
+	}
+
+	var lines []uint32
+	for i, f := range m.fragments {
+		postings := f.lookup(tokens)
+		if i == 0 {
+			lines = postings
+		} else {
+			lines = intersect(lines, postings)
+		}
+		if len(lines) == 0 {
+			return nil, false
+		}
+	}
+	return lines, false
+}
+
+// matches는 엔트리가 검색 조건에 맞는지 실제 내용으로 확인합니다
+func (m *matcher) matches(entry Entry) bool {
+	if !m.query.Since.IsZero() && entry.Timestamp.Before(m.query.Since) {
+		return false
+	}
+	if !m.query.Until.IsZero() && entry.Timestamp.After(m.query.Until) {
+		return false
+	}
+	if m.minLevel > 0 && levelRank(entry.Level) < m.minLevel {
+		return false
+	}
+
+	switch {
+	case m.re != nil:
+		return m.re.MatchString(logs.StripANSI(entry.Message))
+	case m.pattern == "":
+		return true
+	case m.query.IgnoreCase:
+		return strings.Contains(strings.ToLower(logs.StripANSI(entry.Message)), m.pattern)
+	default:
+		return strings.Contains(logs.StripANSI(entry.Message), m.pattern)
+	}
+}
+
+// fragment는 검색어 안의 단어 하나입니다. 검색어 가장자리에 걸친 단어는
+// 색인된 토큰의 일부일 수 있으므로 해당 방향이 열려 있습니다.
+type fragment struct {
+	word      string
+	leftOpen  bool
+	rightOpen bool
+}
+
+// literalFragments는 리터럴 문자열에서 색인으로 찾을 수 있는 단어들을 추출합니다
+func literalFragments(literal string) []fragment {
+	literal = strings.ToLower(literal)
+
+	var fragments []fragment
+	start := -1
+	flush := func(end int) {
+		word := literal[start:end]
+		if len(word) >= minTokenLen {
+			fragments = append(fragments, fragment{
+				word:      word,
+				leftOpen:  start == 0,
+				rightOpen: end == len(literal),
+			})
+		}
+		start = -1
+	}
+	for i, r := range literal {
+		switch {
+		case isWordRune(r) && start < 0:
+			start = i
+		case !isWordRune(r) && start >= 0:
+			flush(i)
+		}
+	}
+	if start >= 0 {
+		flush(len(literal))
+	}
+	return fragments
+}
+
+// lookup은 조각과 일치할 수 있는 토큰의 라인 목록을 합칩니다
+func (f fragment) lookup(tokens map[string][]uint32) []uint32 {
+	if !f.leftOpen && !f.rightOpen && len(f.word) <= maxTokenLen {
+		return tokens[f.word]
+	}
+
+	var lists [][]uint32
+	for token, postings := range tokens {
+		if f.matchesToken(token) {
+			lists = append(lists, postings)
+		}
+	}
+	return union(lists)
+}
+
+// matchesToken은 색인된 토큰이 조각을 포함할 수 있는지 반환합니다.
+// 잘린 토큰은 뒷부분을 알 수 없으므로 보수적으로 판단합니다.
+func (f fragment) matchesToken(token string) bool {
+	if prefix, truncated := strings.CutSuffix(token, truncatedMark); truncated {
+		if f.leftOpen {
+			return true
+		}
+		return strings.HasPrefix(f.word, prefix) || strings.HasPrefix(prefix, f.word)
+	}
+
+	switch {
+	case f.leftOpen && f.rightOpen:
+		return strings.Contains(token, f.word)
+	case f.leftOpen:
+		return strings.HasSuffix(token, f.word)
+	case f.rightOpen:
+		return strings.HasPrefix(token, f.word)
+	default:
+		return token == f.word
+	}
+}
+
+// requiredLiterals는 정규식과 일치하는 문자열이 반드시 포함하는 리터럴을 추출합니다.
+// 선택(|)이나 반복 0회가 가능한 부분은 제외하므로 결과가 비어있을 수 있습니다.
+func requiredLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return []string{string(re.Rune)}
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min >= 1 {
+			return requiredLiterals(re.Sub[0])
+		}
+	case syntax.OpConcat:
+		var literals []string
+		var run []rune
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpLiteral {
+				run = append(run, sub.Rune...)
+				continue
+			}
+			if len(run) > 0 {
+				literals = append(literals, string(run))
+				run = nil
+			}
+			literals = append(literals, requiredLiterals(sub)...)
+		}
+		if len(run) > 0 {
+			literals = append(literals, string(run))
+		}
+		return literals
+	}
+	return nil
+}
+
+// intersect는 정렬된 두 라인 목록의 교집합입니다
+func intersect(a, b []uint32) []uint32 {
+	var out []uint32
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// union은 정렬된 라인 목록들의 합집합입니다
+func union(lists [][]uint32) []uint32 {
+	if len(lists) == 1 {
+		return lists[0]
+	}
+
+	seen := make(map[uint32]bool)
+	var out []uint32
+	for _, list := range lists {
+		for _, line := range list {
+			if !seen[line] {
+				seen[line] = true
+				out = append(out, line)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// pageCursor는 이전 페이지의 마지막 결과 위치입니다
+type pageCursor struct {
+	key  string
+	line int64
+}
+
+// encodePageToken은 마지막 결과의 Worker 키와 라인 번호를 토큰으로 만듭니다
+func encodePageToken(key string, line int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "\x00" + strconv.FormatInt(line, 10)))
+}
+
+// decodePageToken은 페이지 토큰을 해석합니다 (빈 토큰이면 처음부터)
+func decodePageToken(token string) (pageCursor, error) {
+	if token == "" {
+		return pageCursor{line: -1}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+	}
+	key, lineStr, ok := strings.Cut(string(data), "\x00")
+	line, err := strconv.ParseInt(lineStr, 10, 64)
+	if !ok || err != nil {
+		return pageCursor{}, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+	}
+	return pageCursor{key: key, line: line}, nil
+}
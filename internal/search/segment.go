@@ -0,0 +1,258 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+)
+
+const (
+	// minTokenLen보다 짧은 토큰은 색인하지 않습니다
+	minTokenLen = 2
+	// maxTokenLen보다 긴 토큰은 앞부분만 truncatedMark를 붙여 색인합니다
+	maxTokenLen   = 64
+	truncatedMark = "*"
+)
+
+// segmentMeta는 세그먼트 요약입니다 (봉인되면 worker.json에 저장)
+type segmentMeta struct {
+	Seq       int       `json:"seq"`
+	FirstLine int64     `json:"first_line"`
+	Lines     int       `json:"lines"`
+	Size      int64     `json:"size"`
+	MinTime   time.Time `json:"min_ts"`
+	MaxTime   time.Time `json:"max_ts"`
+	MaxLevel  int       `json:"max_level"` // 포함된 가장 높은 레벨 순위 (min_level 필터로 건너뛰기)
+}
+
+// segmentIndex는 .idx 파일에 저장되는 라인 오프셋과 역색인(토큰 → 세그먼트 내 라인)입니다
+type segmentIndex struct {
+	Offsets []int64             `json:"offsets"`
+	Tokens  map[string][]uint32 `json:"tokens"`
+}
+
+// segment는 기록 중인 세그먼트입니다
+type segment struct {
+	meta  segmentMeta
+	file  *os.File
+	index segmentIndex
+}
+
+// segmentPath, indexPath는 세그먼트 파일 경로 규칙을 정의합니다
+func segmentPath(dir string, seq int) string {
+	return filepath.Join(dir, fmt.Sprintf("seg-%06d.jsonl", seq))
+}
+
+func indexPath(dir string, seq int) string {
+	return filepath.Join(dir, fmt.Sprintf("seg-%06d.idx", seq))
+}
+
+// createSegment는 새 세그먼트 파일을 만듭니다
+func createSegment(dir string, meta segmentMeta) (*segment, error) {
+	file, err := os.OpenFile(segmentPath(dir, meta.Seq), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search segment: %w", err)
+	}
+	return &segment{
+		meta:  meta,
+		file:  file,
+		index: segmentIndex{Tokens: make(map[string][]uint32)},
+	}, nil
+}
+
+// recoverSegment는 봉인되지 않은 세그먼트 파일을 다시 읽어 색인합니다.
+// 기록 도중 끊긴 마지막 줄은 잘라냅니다.
+func recoverSegment(path string, meta segmentMeta) (*segment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	s := &segment{meta: meta, index: segmentIndex{Tokens: make(map[string][]uint32)}}
+	err = readLines(file, func(offset int64, line []byte) bool {
+		var entry Entry
+		if json.Unmarshal(line, &entry) != nil {
+			return false
+		}
+		s.add(entry, offset)
+		s.meta.Size = offset + int64(len(line))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if info, err := file.Stat(); err == nil && info.Size() > s.meta.Size {
+		if err := os.Truncate(path, s.meta.Size); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// append는 엔트리를 파일에 쓰고 색인합니다
+func (s *segment) append(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if _, err := s.file.Write(data); err != nil {
+		return fmt.Errorf("failed to write search segment: %w", err)
+	}
+
+	s.add(entry, s.meta.Size)
+	s.meta.Size += int64(len(data))
+	return nil
+}
+
+// add는 offset 위치의 엔트리를 역색인과 요약에 반영합니다
+func (s *segment) add(entry Entry, offset int64) {
+	line := uint32(len(s.index.Offsets))
+	s.index.Offsets = append(s.index.Offsets, offset)
+
+	for _, token := range tokenize(logs.StripANSI(entry.Message)) {
+		postings := s.index.Tokens[token]
+		if n := len(postings); n > 0 && postings[n-1] == line {
+			continue
+		}
+		s.index.Tokens[token] = append(postings, line)
+	}
+
+	s.meta.Lines++
+	if s.meta.MinTime.IsZero() || entry.Timestamp.Before(s.meta.MinTime) {
+		s.meta.MinTime = entry.Timestamp
+	}
+	if entry.Timestamp.After(s.meta.MaxTime) {
+		s.meta.MaxTime = entry.Timestamp
+	}
+	if rank := levelRank(entry.Level); rank > s.meta.MaxLevel {
+		s.meta.MaxLevel = rank
+	}
+}
+
+// seal은 파일을 닫고 역색인을 .idx 파일로 저장합니다
+func (s *segment) seal(dir string) error {
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+		s.file = nil
+	}
+	if s.meta.Lines == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(s.index)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(indexPath(dir, s.meta.Seq), data); err != nil {
+		return fmt.Errorf("failed to write search index: %w", err)
+	}
+	return nil
+}
+
+// view는 지금까지 기록된 내용의 복사본을 반환합니다 (workerLog.mu를 잡은 상태)
+func (s *segment) view(m *matcher) *segmentView {
+	view := &segmentView{
+		meta:    s.meta,
+		offsets: append([]int64(nil), s.index.Offsets...),
+	}
+	view.candidates, view.all = m.candidates(s.index.Tokens)
+	return view
+}
+
+// segmentView는 검색 중 읽는 세그먼트 하나입니다
+type segmentView struct {
+	meta       segmentMeta
+	offsets    []int64
+	candidates []uint32 // 검색어 토큰을 포함하는 라인 (all이면 전체)
+	all        bool
+}
+
+// loadSegmentView는 봉인된 세그먼트의 .idx 파일을 읽습니다
+func loadSegmentView(dir string, meta segmentMeta, m *matcher) (*segmentView, error) {
+	data, err := os.ReadFile(indexPath(dir, meta.Seq))
+	if err != nil {
+		return nil, err
+	}
+
+	var index segmentIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("invalid search index %s: %w", indexPath(dir, meta.Seq), err)
+	}
+
+	view := &segmentView{meta: meta, offsets: index.Offsets}
+	view.candidates, view.all = m.candidates(index.Tokens)
+	return view, nil
+}
+
+// readEntry는 세그먼트의 i번째 라인을 읽습니다
+func (v *segmentView) readEntry(file *os.File, i int) (Entry, error) {
+	start := v.offsets[i]
+	end := v.meta.Size
+	if i+1 < len(v.offsets) {
+		end = v.offsets[i+1]
+	}
+
+	buf := make([]byte, end-start)
+	if _, err := file.ReadAt(buf, start); err != nil {
+		return Entry{}, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(buf, &entry); err != nil {
+		return Entry{}, fmt.Errorf("invalid search segment entry: %w", err)
+	}
+	return entry, nil
+}
+
+// tokenize는 텍스트를 소문자 토큰(글자, 숫자, '_'의 연속)으로 나눕니다.
+// 짧은 토큰은 버리고 긴 토큰은 앞부분에 truncatedMark를 붙입니다.
+func tokenize(text string) []string {
+	var tokens []string
+	for _, word := range words(strings.ToLower(text)) {
+		if token := indexToken(word); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// indexToken은 단어 하나를 색인 형식으로 바꿉니다 (색인하지 않으면 빈 문자열)
+func indexToken(word string) string {
+	if len(word) < minTokenLen {
+		return ""
+	}
+	if len(word) > maxTokenLen {
+		return truncateToken(word) + truncatedMark
+	}
+	return word
+}
+
+// truncateToken은 단어를 maxTokenLen 바이트 이하로 자릅니다 (UTF-8 문자 경계 유지)
+func truncateToken(word string) string {
+	cut := maxTokenLen
+	for cut > 0 && !utf8.RuneStart(word[cut]) {
+		cut--
+	}
+	return word[:cut]
+}
+
+// isWordRune은 토큰을 이루는 문자인지 반환합니다
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// words는 토큰 문자의 연속 구간을 나눕니다
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool { return !isWordRune(r) })
+}
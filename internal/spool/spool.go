@@ -0,0 +1,372 @@
+// Package spool provides a bounded on-disk queue for forwarded worker logs.
+//
+// 이 패키지는 Otto-handler로 보낼 Worker 로그를 디스크에 먼저 기록하여
+// Otto-handler가 중단되거나 Ottoscaler가 재시작되어도 로그가 유실되지 않도록 합니다.
+//
+// 동작 방식:
+//   - Append는 엔트리에 Worker별 순번(sequence)을 붙여 작업(Task)별 스풀에 기록하고 fsync한 뒤 반환
+//     (동시에 기록된 엔트리는 한 번의 fsync로 함께 반영하는 그룹 커밋)
+//   - 백그라운드 Drain 루프가 기록된 순서대로 Otto-handler로 전달하고, ACK를 받은 위치까지만 저장
+//   - 전달 실패 시 지수 백오프로 재시도하며, 재시작 후에는 저장된 위치부터 이어서 전달
+//   - 같은 엔트리가 다시 전달될 수 있으므로 Otto-handler는 (worker_id, sequence)로 중복을 제거
+//
+// 저장 구조:
+//
+//	<dir>/<task>/seg-<n>.log    protojson 엔트리 (한 줄에 하나)
+//	<dir>/<task>/state.json     전달 위치와 Worker별 마지막 순번
+package spool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// DefaultMaxBytes는 작업별 스풀의 기본 최대 크기입니다
+	DefaultMaxBytes = 64 << 20
+	// DefaultSegmentBytes는 세그먼트 파일 하나의 기본 최대 크기입니다
+	DefaultSegmentBytes = 4 << 20
+
+	// drainBatchSize는 한 번에 읽어 전달하는 최대 엔트리 수입니다
+	drainBatchSize = 500
+	// drainInterval은 새 엔트리 알림이 없어도 스풀을 확인하는 간격입니다
+	drainInterval = 1 * time.Second
+	// minRetryDelay, maxRetryDelay는 전달 실패 시 재시도 간격의 범위입니다
+	minRetryDelay = 1 * time.Second
+	maxRetryDelay = 30 * time.Second
+	// idleTimeout 동안 새 엔트리가 없고 모두 전달된 작업 스풀은 스트림을 닫고 삭제합니다
+	idleTimeout = 1 * time.Minute
+	// retiredTTL 동안 삭제된 스풀의 Worker별 마지막 순번을 기억합니다 (순번이 다시 1부터 시작하지 않도록)
+	retiredTTL = 24 * time.Hour
+)
+
+// ErrFull은 작업 스풀이 최대 크기에 도달했을 때 반환됩니다
+var ErrFull = errors.New("log spool is full")
+
+// Forwarder delivers spooled entries to otto-handler.
+//
+// Forwarder는 스풀된 엔트리를 Otto-handler로 전달합니다.
+// grpc.OttoHandlerClient가 이 인터페이스를 구현합니다.
+type Forwarder interface {
+	StartLogStream(ctx context.Context, workerID string, taskID string) error
+	ForwardLogEntry(ctx context.Context, entry *pb.WorkerLogEntry) error
+	CloseLogStream(workerID string) error
+	// FlushLogStream은 보낸 엔트리가 모두 Otto-handler에 ACK될 때까지 기다립니다
+	FlushLogStream(ctx context.Context, workerID string) error
+}
+
+// Config configures the spool.
+//
+// Config는 스풀 설정입니다.
+type Config struct {
+	Dir          string // 스풀 디렉토리
+	MaxBytes     int64  // 작업별 최대 크기 (0이면 DefaultMaxBytes)
+	SegmentBytes int64  // 세그먼트 파일 최대 크기 (0이면 DefaultSegmentBytes)
+}
+
+// Stats is a snapshot of the spool.
+//
+// Stats는 스풀 상태의 스냅샷입니다.
+type Stats struct {
+	Tasks     int   // 스풀이 있는 작업 수
+	Bytes     int64 // 디스크에 남아있는 크기
+	Appended  int64 // 기록된 엔트리 수 (이번 실행)
+	Forwarded int64 // 전달된 엔트리 수 (이번 실행)
+	Rejected  int64 // 스풀이 가득 차서 거부된 엔트리 수
+}
+
+// Spool is a set of per-task on-disk queues drained to otto-handler.
+//
+// Spool은 작업별 디스크 큐의 집합입니다. Append와 Run은 동시에 호출할 수 있습니다.
+type Spool struct {
+	config Config
+
+	mu      sync.Mutex
+	tasks   map[string]*taskSpool
+	retired map[string]retiredSequence // 삭제된 스풀의 Worker별 마지막 순번
+
+	notify chan struct{}
+
+	statsMu sync.Mutex
+	stats   Stats
+}
+
+// retiredSequence는 삭제된 스풀에서 Worker가 사용한 마지막 순번입니다
+type retiredSequence struct {
+	sequence  int64
+	retiredAt time.Time
+}
+
+// Open opens the spool directory and restores queues left by a previous run.
+//
+// Open은 스풀 디렉토리를 열고 이전 실행에서 전달하지 못한 큐를 복원합니다.
+func Open(config Config) (*Spool, error) {
+	if config.Dir == "" {
+		return nil, fmt.Errorf("spool directory is required")
+	}
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = DefaultMaxBytes
+	}
+	if config.SegmentBytes <= 0 {
+		config.SegmentBytes = DefaultSegmentBytes
+	}
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory %s: %w", config.Dir, err)
+	}
+
+	s := &Spool{
+		config:  config,
+		tasks:   make(map[string]*taskSpool),
+		retired: make(map[string]retiredSequence),
+		notify:  make(chan struct{}, 1),
+	}
+
+	dirs, err := os.ReadDir(config.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		key, err := url.PathUnescape(dir.Name())
+		if err != nil {
+			continue
+		}
+
+		task, err := openTaskSpool(filepath.Join(config.Dir, dir.Name()), key, config.SegmentBytes)
+		if err != nil {
+			log.Printf("⚠️ 스풀 %s 복원 실패, 건너뜁니다: %v", dir.Name(), err)
+			continue
+		}
+		s.tasks[key] = task
+	}
+
+	if len(s.tasks) > 0 {
+		log.Printf("💾 이전 실행의 로그 스풀 %d개 복원, 이어서 전달합니다", len(s.tasks))
+		s.wake()
+	}
+	return s, nil
+}
+
+// spoolKey는 엔트리가 기록될 스풀 이름을 반환합니다 (작업 ID가 없으면 Worker별)
+func spoolKey(entry *pb.WorkerLogEntry) string {
+	if entry.TaskId != "" && entry.TaskId != "unknown" {
+		return entry.TaskId
+	}
+	return "worker-" + entry.WorkerId
+}
+
+// Append writes an entry to its task spool and assigns the next worker sequence.
+//
+// Append는 엔트리에 Worker별 다음 순번을 붙여 작업 스풀에 기록합니다.
+// 반환되면 엔트리는 디스크에 기록(fsync)된 상태이며, 전달은 Run 루프가 담당합니다.
+// 스풀이 가득 차면 ErrFull을 반환합니다.
+func (s *Spool) Append(entry *pb.WorkerLogEntry) (int64, error) {
+	if entry.WorkerId == "" {
+		return 0, fmt.Errorf("worker id is required")
+	}
+
+	key := spoolKey(entry)
+
+	var sequence int64
+	var err error
+	for {
+		s.mu.Lock()
+		task, exists := s.tasks[key]
+		if !exists {
+			task, err = openTaskSpool(filepath.Join(s.config.Dir, url.PathEscape(key)), key, s.config.SegmentBytes)
+			if err != nil {
+				s.mu.Unlock()
+				return 0, err
+			}
+			s.tasks[key] = task
+		}
+		floor := s.retired[entry.WorkerId].sequence
+		s.mu.Unlock()
+
+		sequence, err = task.append(entry, s.config.MaxBytes, floor)
+		if !errors.Is(err, errRetired) {
+			break
+		}
+	}
+
+	s.statsMu.Lock()
+	if errors.Is(err, ErrFull) {
+		s.stats.Rejected++
+	} else if err == nil {
+		s.stats.Appended++
+	}
+	s.statsMu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	s.wake()
+	return sequence, nil
+}
+
+// StartLogStream, ForwardLogEntry, CloseLogStream은 Spool이 worker.LogForwarder로
+// 사용될 수 있게 합니다. 수집된 로그는 스풀에 기록되고 스트림 관리는 Run 루프가 담당합니다.
+
+// StartLogStream은 아무 것도 하지 않습니다 (스트림은 전달 시 열림)
+func (s *Spool) StartLogStream(ctx context.Context, workerID string, taskID string) error {
+	return nil
+}
+
+// ForwardLogEntry는 엔트리를 스풀에 기록합니다
+func (s *Spool) ForwardLogEntry(ctx context.Context, entry *pb.WorkerLogEntry) error {
+	_, err := s.Append(entry)
+	return err
+}
+
+// CloseLogStream은 아무 것도 하지 않습니다 (모두 전달된 뒤 Run 루프가 닫음)
+func (s *Spool) CloseLogStream(workerID string) error {
+	return nil
+}
+
+// Stats는 스풀 상태를 반환합니다
+func (s *Spool) Stats() Stats {
+	s.mu.Lock()
+	tasks := make([]*taskSpool, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		tasks = append(tasks, task)
+	}
+	s.mu.Unlock()
+
+	s.statsMu.Lock()
+	stats := s.stats
+	s.statsMu.Unlock()
+
+	stats.Tasks = len(tasks)
+	for _, task := range tasks {
+		stats.Bytes += task.size()
+	}
+	return stats
+}
+
+// wake는 Run 루프에 새 엔트리가 있음을 알립니다
+func (s *Spool) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run drains all task spools to the forwarder until ctx is cancelled.
+//
+// Run은 ctx가 취소될 때까지 모든 작업 스풀을 Forwarder로 전달합니다.
+// 작업별로 기록 순서를 유지하며, 실패한 작업은 백오프 후 같은 위치부터 다시 전달합니다.
+func (s *Spool) Run(ctx context.Context, forwarder Forwarder) {
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+
+	for {
+		s.drainOnce(ctx, forwarder)
+
+		select {
+		case <-ctx.Done():
+			s.closeAll(forwarder)
+			return
+		case <-s.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// drainOnce는 재시도 대기 중이 아닌 모든 작업 스풀을 한 번씩 전달합니다
+func (s *Spool) drainOnce(ctx context.Context, forwarder Forwarder) {
+	s.mu.Lock()
+	keys := make([]string, 0, len(s.tasks))
+	for key := range s.tasks {
+		keys = append(keys, key)
+	}
+	s.mu.Unlock()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.mu.Lock()
+		task := s.tasks[key]
+		s.mu.Unlock()
+		if task == nil || time.Now().Before(task.retryAt) {
+			continue
+		}
+
+		forwarded, err := task.drain(ctx, forwarder)
+		if forwarded > 0 {
+			s.statsMu.Lock()
+			s.stats.Forwarded += int64(forwarded)
+			s.statsMu.Unlock()
+		}
+		if err != nil {
+			task.backoff(err)
+			continue
+		}
+		task.failures = 0
+
+		// 모두 전달되었고 한동안 새 엔트리가 없으면 스트림을 닫고 스풀 삭제
+		if s.retire(key, task) {
+			task.closeStreams(forwarder)
+		}
+	}
+
+	s.pruneRetired()
+}
+
+// retire는 유휴 상태인 작업 스풀을 목록과 디스크에서 삭제하고 마지막 순번을 기억합니다
+func (s *Spool) retire(key string, task *taskSpool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sequences, ok := task.retireIfIdle(idleTimeout)
+	if !ok {
+		return false
+	}
+
+	delete(s.tasks, key)
+	now := time.Now()
+	for workerID, sequence := range sequences {
+		s.retired[workerID] = retiredSequence{sequence: sequence, retiredAt: now}
+	}
+	if err := os.RemoveAll(task.dir); err != nil {
+		log.Printf("⚠️ 스풀 %s 삭제 실패: %v", key, err)
+	}
+	return true
+}
+
+// pruneRetired는 오래된 Worker 순번 기록을 정리합니다
+func (s *Spool) pruneRetired() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for workerID, retired := range s.retired {
+		if time.Since(retired.retiredAt) > retiredTTL {
+			delete(s.retired, workerID)
+		}
+	}
+}
+
+// closeAll은 종료 시 열린 스트림을 닫습니다 (스풀 파일은 다음 실행을 위해 유지)
+func (s *Spool) closeAll(forwarder Forwarder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, task := range s.tasks {
+		task.closeStreams(forwarder)
+		task.close()
+	}
+}
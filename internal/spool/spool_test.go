@@ -0,0 +1,257 @@
+package spool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// fakeForwarder는 전달된 엔트리를 기록하는 테스트용 Forwarder입니다
+type fakeForwarder struct {
+	entries   []*pb.WorkerLogEntry
+	failFlush bool
+	closed    map[string]int
+}
+
+func (f *fakeForwarder) StartLogStream(ctx context.Context, workerID string, taskID string) error {
+	return nil
+}
+
+func (f *fakeForwarder) ForwardLogEntry(ctx context.Context, entry *pb.WorkerLogEntry) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func (f *fakeForwarder) CloseLogStream(workerID string) error {
+	if f.closed == nil {
+		f.closed = make(map[string]int)
+	}
+	f.closed[workerID]++
+	return nil
+}
+
+func (f *fakeForwarder) FlushLogStream(ctx context.Context, workerID string) error {
+	if f.failFlush {
+		return errors.New("not acknowledged")
+	}
+	return nil
+}
+
+// appendN은 Worker의 엔트리 n개를 기록합니다
+func appendN(t *testing.T, s *Spool, workerID string, from, n int) {
+	t.Helper()
+	for i := from; i < from+n; i++ {
+		entry := &pb.WorkerLogEntry{WorkerId: workerID, TaskId: "task-1", Message: fmt.Sprintf("line %d", i)}
+		if _, err := s.Append(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// checkSequences는 전달된 엔트리의 순번이 first부터 연속인지 확인합니다
+func checkSequences(t *testing.T, entries []*pb.WorkerLogEntry, first int64) {
+	t.Helper()
+	for i, entry := range entries {
+		if want := first + int64(i); entry.Sequence != want {
+			t.Fatalf("entry %d: sequence = %d, want %d", i, entry.Sequence, want)
+		}
+		if want := fmt.Sprintf("line %d", first+int64(i)-1); entry.Message != want {
+			t.Fatalf("entry %d: message = %q, want %q", i, entry.Message, want)
+		}
+	}
+}
+
+func TestSpoolRecoversTornTail(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(Config{Dir: dir, SegmentBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendN(t, s, "worker-1", 0, 30)
+
+	// 비정상 종료: 파일을 닫지 않고 마지막 세그먼트에 끝나지 않은 줄을 남김
+	segments, err := filepath.Glob(filepath.Join(dir, "task-1", "seg-*.log"))
+	if err != nil || len(segments) < 2 {
+		t.Fatalf("expected several segments, got %v (%v)", segments, err)
+	}
+	last := segments[len(segments)-1]
+	file, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteString(`{"workerId":"worker-1","message":"torn`); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	s, err = Open(Config{Dir: dir, SegmentBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 복원된 순번 다음부터 이어서 기록
+	appendN(t, s, "worker-1", 30, 5)
+
+	forwarder := &fakeForwarder{}
+	s.drainOnce(context.Background(), forwarder)
+
+	if len(forwarder.entries) != 35 {
+		t.Fatalf("forwarded %d entries, want 35", len(forwarder.entries))
+	}
+	checkSequences(t, forwarder.entries, 1)
+	if stats := s.Stats(); stats.Forwarded != 35 {
+		t.Fatalf("stats.Forwarded = %d, want 35", stats.Forwarded)
+	}
+}
+
+func TestSpoolResumesFromSavedOffset(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(Config{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendN(t, s, "worker-1", 0, drainBatchSize+100)
+
+	// 한 번에 drainBatchSize개까지만 전달하고 위치 저장
+	first := &fakeForwarder{}
+	s.drainOnce(context.Background(), first)
+	if len(first.entries) != drainBatchSize {
+		t.Fatalf("forwarded %d entries, want %d", len(first.entries), drainBatchSize)
+	}
+	s.closeAll(first)
+
+	// 재시작 후에는 저장된 위치부터 이어서 전달
+	s, err = Open(Config{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := &fakeForwarder{}
+	s.drainOnce(context.Background(), second)
+	if len(second.entries) != 100 {
+		t.Fatalf("forwarded %d entries after restart, want 100", len(second.entries))
+	}
+	checkSequences(t, second.entries, drainBatchSize+1)
+}
+
+func TestSpoolRetransmitsUnacknowledged(t *testing.T) {
+	s, err := Open(Config{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendN(t, s, "worker-1", 0, 10)
+
+	failing := &fakeForwarder{failFlush: true}
+	s.drainOnce(context.Background(), failing)
+	if failing.closed["worker-1"] == 0 {
+		t.Fatal("stream should be closed after a failed flush")
+	}
+
+	// ACK되지 않은 구간은 처음부터 다시 전달
+	task := s.tasks["task-1"]
+	task.retryAt = time.Time{}
+	forwarder := &fakeForwarder{}
+	s.drainOnce(context.Background(), forwarder)
+	if len(forwarder.entries) != 10 {
+		t.Fatalf("forwarded %d entries, want 10", len(forwarder.entries))
+	}
+	checkSequences(t, forwarder.entries, 1)
+}
+
+func TestSpoolRetiredSequenceFloor(t *testing.T) {
+	s, err := Open(Config{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendN(t, s, "worker-1", 0, 3)
+
+	forwarder := &fakeForwarder{}
+	s.drainOnce(context.Background(), forwarder)
+
+	// 유휴 상태로 만들어 스풀 정리
+	task := s.tasks["task-1"]
+	task.mu.Lock()
+	task.lastAppend = time.Now().Add(-2 * idleTimeout)
+	task.mu.Unlock()
+	s.drainOnce(context.Background(), forwarder)
+	if _, exists := s.tasks["task-1"]; exists {
+		t.Fatal("idle spool should be retired")
+	}
+	if _, err := os.Stat(task.dir); !os.IsNotExist(err) {
+		t.Fatalf("retired spool directory still exists: %v", err)
+	}
+
+	// 새 스풀에서도 순번은 1부터 다시 시작하지 않음
+	appendN(t, s, "worker-1", 3, 2)
+	s.drainOnce(context.Background(), forwarder)
+	if len(forwarder.entries) != 5 {
+		t.Fatalf("forwarded %d entries, want 5", len(forwarder.entries))
+	}
+	checkSequences(t, forwarder.entries, 1)
+}
+
+func TestSpoolConcurrentAppend(t *testing.T) {
+	s, err := Open(Config{Dir: t.TempDir(), SegmentBytes: 4096})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 여러 Worker가 같은 작업 스풀에 동시에 기록해도 Worker별 순번은 빠짐없이 증가
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(workerID string) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				if _, err := s.Append(&pb.WorkerLogEntry{WorkerId: workerID, TaskId: "task-1", Message: "line"}); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(fmt.Sprintf("worker-%d", w))
+	}
+	wg.Wait()
+
+	forwarder := &fakeForwarder{}
+	s.drainOnce(context.Background(), forwarder)
+	if len(forwarder.entries) != 400 {
+		t.Fatalf("forwarded %d entries, want 400", len(forwarder.entries))
+	}
+	last := make(map[string]int64)
+	for _, entry := range forwarder.entries {
+		if entry.Sequence != last[entry.WorkerId]+1 {
+			t.Fatalf("%s: sequence %d after %d", entry.WorkerId, entry.Sequence, last[entry.WorkerId])
+		}
+		last[entry.WorkerId] = entry.Sequence
+	}
+}
+
+func TestSpoolRejectsWhenFull(t *testing.T) {
+	s, err := Open(Config{Dir: t.TempDir(), MaxBytes: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rejected bool
+	for i := 0; i < 10; i++ {
+		_, err := s.Append(&pb.WorkerLogEntry{WorkerId: "worker-1", TaskId: "task-1", Message: "0123456789"})
+		if errors.Is(err, ErrFull) {
+			rejected = true
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !rejected {
+		t.Fatal("Append should return ErrFull when the spool is full")
+	}
+	if stats := s.Stats(); stats.Rejected != 1 || stats.Bytes > 200 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,586 @@
+package spool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const stateFileName = "state.json"
+
+// errRetired는 이미 정리된 작업 스풀에 기록하려 할 때 반환됩니다 (Append가 새 스풀로 재시도)
+var errRetired = errors.New("task spool retired")
+
+// spoolState는 state.json에 저장되는 전달 위치와 Worker별 마지막 순번입니다
+type spoolState struct {
+	ReadSegment int64            `json:"read_segment"`
+	ReadOffset  int64            `json:"read_offset"`
+	Sequences   map[string]int64 `json:"sequences"`
+}
+
+// taskSpool은 작업 하나의 디스크 큐입니다
+type taskSpool struct {
+	dir          string
+	key          string
+	segmentBytes int64
+
+	mu         sync.Mutex
+	segments   []int64         // 존재하는 세그먼트 번호 (오름차순)
+	sizes      map[int64]int64 // 세그먼트별 크기
+	writer     *os.File        // 마지막 세그먼트 (기록용)
+	state      spoolState
+	lastAppend time.Time
+	retired    bool
+	written    uint64 // 기록한 엔트리 수 (fsync 대기 순번)
+
+	// 그룹 커밋: fsync를 기다리는 append들은 한 번의 fsync로 함께 반영됨
+	syncMu sync.Mutex
+	synced uint64 // fsync로 디스크에 반영된 엔트리 수
+
+	// Run 루프에서만 사용
+	streams  map[string]bool // 열린 Otto-handler 스트림 (Worker ID)
+	failures int
+	retryAt  time.Time
+}
+
+// openTaskSpool은 작업 스풀 디렉토리를 열거나 생성하고 상태를 복원합니다
+func openTaskSpool(dir, key string, segmentBytes int64) (*taskSpool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool %s: %w", dir, err)
+	}
+
+	t := &taskSpool{
+		dir:          dir,
+		key:          key,
+		segmentBytes: segmentBytes,
+		sizes:        make(map[int64]int64),
+		state:        spoolState{Sequences: make(map[string]int64)},
+		lastAppend:   time.Now(),
+		streams:      make(map[string]bool),
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, stateFileName)); err == nil {
+		if err := json.Unmarshal(data, &t.state); err != nil {
+			log.Printf("⚠️ 스풀 %s의 상태 파일이 손상되어 처음부터 전달합니다: %v", key, err)
+			t.state = spoolState{}
+		}
+		if t.state.Sequences == nil {
+			t.state.Sequences = make(map[string]int64)
+		}
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		name := file.Name()
+		if !strings.HasPrefix(name, "seg-") || !strings.HasSuffix(name, ".log") {
+			continue
+		}
+		id, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, "seg-"), ".log"), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return nil, err
+		}
+		t.segments = append(t.segments, id)
+		t.sizes[id] = info.Size()
+	}
+	sort.Slice(t.segments, func(i, j int) bool { return t.segments[i] < t.segments[j] })
+
+	if len(t.segments) == 0 {
+		return t, nil
+	}
+
+	// 비정상 종료로 마지막 줄이 잘렸으면 제거
+	if err := t.repairLastSegment(); err != nil {
+		return nil, err
+	}
+
+	// 전달 위치가 삭제된 세그먼트를 가리키면 남은 첫 세그먼트부터
+	if t.state.ReadSegment < t.segments[0] {
+		t.state.ReadSegment = t.segments[0]
+		t.state.ReadOffset = 0
+	}
+
+	// 상태 저장 이후 기록된 엔트리의 순번 복원
+	if err := t.restoreSequences(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// segmentPath는 세그먼트 파일 경로를 반환합니다
+func (t *taskSpool) segmentPath(id int64) string {
+	return filepath.Join(t.dir, fmt.Sprintf("seg-%020d.log", id))
+}
+
+// repairLastSegment는 마지막 세그먼트의 끝나지 않은 줄을 잘라냅니다
+func (t *taskSpool) repairLastSegment() error {
+	last := t.segments[len(t.segments)-1]
+	data, err := os.ReadFile(t.segmentPath(last))
+	if err != nil {
+		return err
+	}
+
+	valid := int64(bytes.LastIndexByte(data, '\n') + 1)
+	if valid == int64(len(data)) {
+		return nil
+	}
+
+	log.Printf("⚠️ 스풀 %s의 마지막 엔트리가 불완전하여 제거합니다 (%d바이트)", t.key, int64(len(data))-valid)
+	if err := os.Truncate(t.segmentPath(last), valid); err != nil {
+		return err
+	}
+	t.sizes[last] = valid
+	return nil
+}
+
+// restoreSequences는 남아있는 엔트리를 읽어 Worker별 마지막 순번을 갱신합니다
+func (t *taskSpool) restoreSequences() error {
+	for _, id := range t.segments {
+		file, err := os.Open(t.segmentPath(id))
+		if err != nil {
+			return err
+		}
+
+		reader := bufio.NewReader(file)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(line) > 0 {
+				var entry pb.WorkerLogEntry
+				if protojson.Unmarshal(bytes.TrimSpace(line), &entry) == nil && entry.Sequence > t.state.Sequences[entry.WorkerId] {
+					t.state.Sequences[entry.WorkerId] = entry.Sequence
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+		file.Close()
+	}
+	return nil
+}
+
+// append는 엔트리에 순번을 붙여 기록하고 디스크에 반영(fsync)된 뒤 반환합니다.
+// floor는 이전에 정리된 스풀에서 사용한 마지막 순번입니다.
+func (t *taskSpool) append(entry *pb.WorkerLogEntry, maxBytes, floor int64) (int64, error) {
+	sequence, written, err := t.write(entry, maxBytes, floor)
+	if err != nil {
+		return 0, err
+	}
+	// Worker에 ACK하기 전에 디스크에 반영
+	if err := t.syncTo(written); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// write는 엔트리를 기록용 세그먼트에 쓰고 순번과 fsync 대기 순번을 반환합니다
+func (t *taskSpool) write(entry *pb.WorkerLogEntry, maxBytes, floor int64) (int64, uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.retired {
+		return 0, 0, errRetired
+	}
+
+	sequence := t.state.Sequences[entry.WorkerId]
+	if floor > sequence {
+		sequence = floor
+	}
+	sequence++
+	entry.Sequence = sequence
+
+	data, err := protojson.Marshal(entry)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to encode log entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	if t.sizeLocked()+int64(len(data)) > maxBytes {
+		return 0, 0, ErrFull
+	}
+
+	if err := t.ensureWriterLocked(int64(len(data))); err != nil {
+		return 0, 0, err
+	}
+	if _, err := t.writer.Write(data); err != nil {
+		return 0, 0, fmt.Errorf("failed to write log spool: %w", err)
+	}
+
+	current := t.segments[len(t.segments)-1]
+	t.sizes[current] += int64(len(data))
+	t.state.Sequences[entry.WorkerId] = sequence
+	t.lastAppend = time.Now()
+	t.written++
+	return sequence, t.written, nil
+}
+
+// syncTo는 written번째 엔트리까지 디스크에 반영될 때까지 기다립니다.
+// 먼저 syncMu를 얻은 append가 그때까지 기록된 모든 엔트리를 한 번에 fsync하고,
+// 기다리던 append들은 이미 반영되었으면 fsync 없이 반환합니다 (그룹 커밋).
+// 기록 중인 세그먼트는 t.mu를 잡지 않고 fsync하므로 fsync 동안에도 다른 append가 기록할 수 있습니다.
+func (t *taskSpool) syncTo(written uint64) error {
+	t.syncMu.Lock()
+	defer t.syncMu.Unlock()
+
+	if t.synced >= written {
+		return nil
+	}
+
+	t.mu.Lock()
+	target, writer := t.written, t.writer
+	t.mu.Unlock()
+
+	// 세그먼트 교체나 종료로 닫힌 파일은 닫기 전에 fsync됨 (closeWriterLocked)
+	if writer != nil {
+		if err := writer.Sync(); err != nil && !errors.Is(err, os.ErrClosed) {
+			return fmt.Errorf("failed to sync log spool: %w", err)
+		}
+	}
+	t.synced = target
+	return nil
+}
+
+// closeWriterLocked는 기록 중인 세그먼트를 fsync하고 닫습니다
+func (t *taskSpool) closeWriterLocked() error {
+	if t.writer == nil {
+		return nil
+	}
+	err := t.writer.Sync()
+	t.writer.Close()
+	t.writer = nil
+	if err != nil {
+		return fmt.Errorf("failed to sync log spool: %w", err)
+	}
+	return nil
+}
+
+// ensureWriterLocked는 기록할 세그먼트를 열고, 가득 찼으면 새 세그먼트로 교체합니다
+func (t *taskSpool) ensureWriterLocked(size int64) error {
+	if len(t.segments) > 0 {
+		current := t.segments[len(t.segments)-1]
+		if t.sizes[current] == 0 || t.sizes[current]+size <= t.segmentBytes {
+			if t.writer != nil {
+				return nil
+			}
+			file, err := os.OpenFile(t.segmentPath(current), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+			if err != nil {
+				return err
+			}
+			t.writer = file
+			return nil
+		}
+	}
+
+	if err := t.closeWriterLocked(); err != nil {
+		return err
+	}
+
+	next := int64(1)
+	if len(t.segments) > 0 {
+		next = t.segments[len(t.segments)-1] + 1
+	}
+	file, err := os.OpenFile(t.segmentPath(next), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	// 새 세그먼트 파일의 디렉토리 엔트리도 디스크에 반영
+	if err := syncDir(t.dir); err != nil {
+		file.Close()
+		return err
+	}
+
+	if len(t.segments) == 0 {
+		t.state.ReadSegment = next
+		t.state.ReadOffset = 0
+	}
+	t.segments = append(t.segments, next)
+	t.sizes[next] = 0
+	t.writer = file
+	return nil
+}
+
+// size는 디스크에 남아있는 크기를 반환합니다
+func (t *taskSpool) size() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sizeLocked()
+}
+
+func (t *taskSpool) sizeLocked() int64 {
+	var total int64
+	for _, size := range t.sizes {
+		total += size
+	}
+	return total
+}
+
+// drain은 전달 위치부터 엔트리를 읽어 Forwarder로 전달하고 위치를 저장합니다.
+// 최대 drainBatchSize개를 전달하며, 위치는 Otto-handler가 ACK한 범위까지만 옮깁니다.
+func (t *taskSpool) drain(ctx context.Context, forwarder Forwarder) (int, error) {
+	forwarded := 0
+	for forwarded < drainBatchSize {
+		t.mu.Lock()
+		segment, offset := t.state.ReadSegment, t.state.ReadOffset
+		end, exists := t.sizes[segment]
+		last := len(t.segments) == 0 || segment == t.segments[len(t.segments)-1]
+		t.mu.Unlock()
+
+		if !exists {
+			return forwarded, nil
+		}
+
+		if offset >= end {
+			if last {
+				return forwarded, nil
+			}
+			if err := t.advanceSegment(segment); err != nil {
+				return forwarded, err
+			}
+			continue
+		}
+
+		n, newOffset, err := t.forwardRange(ctx, forwarder, segment, offset, end, drainBatchSize-forwarded)
+		forwarded += n
+
+		t.mu.Lock()
+		t.state.ReadOffset = newOffset
+		t.mu.Unlock()
+		if saveErr := t.saveState(); saveErr != nil && err == nil {
+			err = saveErr
+		}
+		if err != nil {
+			return forwarded, err
+		}
+	}
+	return forwarded, nil
+}
+
+// forwardRange는 세그먼트의 [offset, end) 구간에서 최대 limit개의 엔트리를 전달하고
+// 모두 ACK되면 다음 위치를 반환합니다. 전송이나 ACK에 실패하면 스트림을 닫고 처음 위치를
+// 반환하므로 같은 구간을 다시 전달합니다 (중복은 Otto-handler가 sequence로 제거).
+func (t *taskSpool) forwardRange(ctx context.Context, forwarder Forwarder, segment, offset, end int64, limit int) (int, int64, error) {
+	start := offset
+	file, err := os.Open(t.segmentPath(segment))
+	if err != nil {
+		return 0, offset, err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, offset, err
+	}
+	reader := bufio.NewReader(io.LimitReader(file, end-offset))
+
+	forwarded := 0
+	sent := make(map[string]bool) // 이번 구간에서 엔트리를 보낸 Worker
+	for forwarded < limit {
+		line, err := reader.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			break
+		}
+		if !bytes.HasSuffix(line, []byte("\n")) {
+			break // 아직 기록 중인 줄 (end 스냅샷 이후)
+		}
+
+		var entry pb.WorkerLogEntry
+		if err := protojson.Unmarshal(bytes.TrimSpace(line), &entry); err != nil {
+			log.Printf("⚠️ 스풀 %s의 손상된 엔트리를 건너뜁니다: %v", t.key, err)
+			offset += int64(len(line))
+			continue
+		}
+
+		if !t.streams[entry.WorkerId] {
+			if err := forwarder.StartLogStream(ctx, entry.WorkerId, entry.TaskId); err != nil {
+				t.resetStreams(forwarder, sent)
+				return 0, start, fmt.Errorf("failed to start log stream for %s: %w", entry.WorkerId, err)
+			}
+			t.streams[entry.WorkerId] = true
+		}
+
+		sent[entry.WorkerId] = true
+		if err := forwarder.ForwardLogEntry(ctx, &entry); err != nil {
+			// 스트림을 다시 열고 처음 위치부터 재전송
+			t.resetStreams(forwarder, sent)
+			return 0, start, fmt.Errorf("failed to forward log of %s: %w", entry.WorkerId, err)
+		}
+
+		offset += int64(len(line))
+		forwarded++
+	}
+
+	// 보낸 엔트리가 모두 ACK된 뒤에만 위치를 옮김
+	for workerID := range sent {
+		if err := forwarder.FlushLogStream(ctx, workerID); err != nil {
+			t.resetStreams(forwarder, sent)
+			return 0, start, fmt.Errorf("logs of %s were not acknowledged: %w", workerID, err)
+		}
+	}
+
+	return forwarded, offset, nil
+}
+
+// resetStreams는 workers의 Otto-handler 스트림을 닫아 다음 전달에서 새로 열리게 합니다
+func (t *taskSpool) resetStreams(forwarder Forwarder, workers map[string]bool) {
+	for workerID := range workers {
+		forwarder.CloseLogStream(workerID)
+		delete(t.streams, workerID)
+	}
+}
+
+// advanceSegment는 모두 전달된 세그먼트를 삭제하고 다음 세그먼트로 넘어갑니다
+func (t *taskSpool) advanceSegment(segment int64) error {
+	t.mu.Lock()
+	index := sort.Search(len(t.segments), func(i int) bool { return t.segments[i] >= segment })
+	if index >= len(t.segments)-1 {
+		t.mu.Unlock()
+		return nil
+	}
+	next := t.segments[index+1]
+	t.segments = append(t.segments[:index], t.segments[index+1:]...)
+	delete(t.sizes, segment)
+	t.state.ReadSegment = next
+	t.state.ReadOffset = 0
+	t.mu.Unlock()
+
+	if err := t.saveState(); err != nil {
+		return err
+	}
+	if err := os.Remove(t.segmentPath(segment)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// saveState는 전달 위치와 순번을 원자적으로 저장합니다
+func (t *taskSpool) saveState() error {
+	t.mu.Lock()
+	state := spoolState{
+		ReadSegment: t.state.ReadSegment,
+		ReadOffset:  t.state.ReadOffset,
+		Sequences:   make(map[string]int64, len(t.state.Sequences)),
+	}
+	for worker, sequence := range t.state.Sequences {
+		state.Sequences[worker] = sequence
+	}
+	t.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(t.dir, stateFileName+".tmp")
+	if err := writeFileSync(tmp, data); err != nil {
+		return fmt.Errorf("failed to save spool state: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(t.dir, stateFileName)); err != nil {
+		return fmt.Errorf("failed to save spool state: %w", err)
+	}
+	return syncDir(t.dir)
+}
+
+// writeFileSync는 파일을 쓰고 닫기 전에 fsync합니다
+func writeFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// syncDir는 디렉토리를 fsync하여 파일 생성과 이름 변경을 디스크에 반영합니다
+func syncDir(dir string) error {
+	file, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s: %w", dir, err)
+	}
+	return nil
+}
+
+// backoff는 전달 실패 후 다음 시도 시간을 지수적으로 늘립니다
+func (t *taskSpool) backoff(err error) {
+	t.failures++
+	delay := minRetryDelay << min(t.failures-1, 5)
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	t.retryAt = time.Now().Add(delay)
+
+	log.Printf("⚠️ 스풀 %s 전달 실패 (%d회째), %v 후 재시도: %v", t.key, t.failures, delay, err)
+}
+
+// retireIfIdle은 모두 전달되었고 timeout 동안 새 엔트리가 없으면 스풀을 닫고
+// Worker별 마지막 순번을 반환합니다. 이후 append는 errRetired를 반환합니다.
+func (t *taskSpool) retireIfIdle(timeout time.Duration) (map[string]int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if time.Since(t.lastAppend) < timeout {
+		return nil, false
+	}
+	if len(t.segments) > 0 {
+		last := t.segments[len(t.segments)-1]
+		if t.state.ReadSegment != last || t.state.ReadOffset < t.sizes[last] {
+			return nil, false
+		}
+	}
+
+	t.retired = true
+	if err := t.closeWriterLocked(); err != nil {
+		log.Printf("⚠️ 스풀 %s 닫기 실패: %v", t.key, err)
+	}
+	return t.state.Sequences, true
+}
+
+// closeStreams는 이 스풀이 연 Otto-handler 스트림을 닫습니다
+func (t *taskSpool) closeStreams(forwarder Forwarder) {
+	for workerID := range t.streams {
+		forwarder.CloseLogStream(workerID)
+	}
+	t.streams = make(map[string]bool)
+}
+
+// close는 기록용 파일을 닫습니다
+func (t *taskSpool) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.closeWriterLocked(); err != nil {
+		log.Printf("⚠️ 스풀 %s 닫기 실패: %v", t.key, err)
+	}
+}
@@ -0,0 +1,135 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// serverCredentials는 서버 핸드셰이크 실패를 원인 설명과 함께 기록하는 자격 증명입니다
+type serverCredentials struct {
+	credentials.TransportCredentials
+}
+
+// ServerHandshake는 TLS 핸드셰이크를 수행하고 실패하면 원인을 기록합니다
+func (c *serverCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, authInfo, err := c.TransportCredentials.ServerHandshake(rawConn)
+	if err != nil {
+		err = handshakeError(fmt.Sprintf("client %s", rawConn.RemoteAddr()), err)
+		// TCP 연결만 확인하고 끊는 프로브는 기록하지 않음
+		if !errors.Is(err, io.EOF) {
+			log.Printf("🔒 %v", err)
+		}
+		return nil, nil, err
+	}
+	return conn, authInfo, nil
+}
+
+// Clone은 자격 증명을 복사합니다
+func (c *serverCredentials) Clone() credentials.TransportCredentials {
+	return &serverCredentials{TransportCredentials: c.TransportCredentials.Clone()}
+}
+
+// clientCredentials는 핸드셰이크마다 Reloader의 최신 CA 번들로 서버를 검증하는 자격 증명입니다
+type clientCredentials struct {
+	base     *tls.Config // RootCAs를 제외한 설정
+	reloader *Reloader
+}
+
+// current는 현재 CA 번들을 사용하는 TLS 자격 증명을 만듭니다 (CA 파일이 없으면 시스템 CA)
+func (c *clientCredentials) current() credentials.TransportCredentials {
+	config := c.base.Clone()
+	config.RootCAs = c.reloader.CAPool()
+	return credentials.NewTLS(config)
+}
+
+// ClientHandshake는 TLS 핸드셰이크를 수행하고 실패하면 원인 설명이 붙은 에러를 반환합니다
+func (c *clientCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, authInfo, err := c.current().ClientHandshake(ctx, authority, rawConn)
+	if err != nil {
+		return nil, nil, handshakeError(fmt.Sprintf("server %s", authority), err)
+	}
+	return conn, authInfo, nil
+}
+
+// ServerHandshake는 클라이언트 자격 증명에서 지원하지 않습니다
+func (c *clientCredentials) ServerHandshake(net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("tlsconfig: client credentials cannot be used by a server")
+}
+
+// Info는 보안 프로토콜 정보를 반환합니다
+func (c *clientCredentials) Info() credentials.ProtocolInfo {
+	return c.current().Info()
+}
+
+// Clone은 자격 증명을 복사합니다
+func (c *clientCredentials) Clone() credentials.TransportCredentials {
+	return &clientCredentials{base: c.base.Clone(), reloader: c.reloader}
+}
+
+// OverrideServerName은 서버 인증서에서 확인할 이름을 바꿉니다
+//
+// Deprecated: grpc.WithAuthority를 사용하세요 (credentials.TransportCredentials 구현용).
+func (c *clientCredentials) OverrideServerName(serverName string) error {
+	c.base.ServerName = serverName
+	return nil
+}
+
+// handshakeError는 핸드셰이크 에러에 흔한 원인과 확인할 설정을 덧붙입니다
+func handshakeError(peer string, err error) error {
+	if hint := handshakeHint(err); hint != "" {
+		return fmt.Errorf("TLS handshake with %s failed: %s: %w", peer, hint, err)
+	}
+	return fmt.Errorf("TLS handshake with %s failed: %w", peer, err)
+}
+
+// handshakeHint는 핸드셰이크 에러의 원인을 설명합니다 (알 수 없으면 빈 문자열)
+func handshakeHint(err error) string {
+	var (
+		hostnameErr  x509.HostnameError
+		authorityErr x509.UnknownAuthorityError
+		invalidErr   x509.CertificateInvalidError
+		recordErr    tls.RecordHeaderError
+	)
+
+	switch {
+	case errors.As(err, &hostnameErr):
+		return fmt.Sprintf("peer certificate is not valid for %q (check server_name and the certificate SANs)", hostnameErr.Host)
+	case errors.As(err, &authorityErr):
+		return "peer certificate is signed by an unknown authority (check ca_file)"
+	case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+		return "peer certificate has expired or is not yet valid"
+	case errors.As(err, &invalidErr) && invalidErr.Reason == x509.IncompatibleUsage:
+		return "peer certificate is not issued for this usage (check extended key usage)"
+	case errors.As(err, &recordErr):
+		return "peer is not speaking TLS (plaintext client or server?)"
+	case strings.Contains(err.Error(), "client didn't provide a certificate"):
+		return "client certificate required (configure the client cert_file and key_file)"
+	}
+
+	// 상대가 보낸 TLS Alert ("remote error: tls: <설명>")
+	message := err.Error()
+	for alert, hint := range remoteAlertHints {
+		if strings.Contains(message, "remote error: tls: "+alert) {
+			return hint
+		}
+	}
+	return ""
+}
+
+// remoteAlertHints는 상대가 보낸 TLS Alert별 원인 설명입니다
+var remoteAlertHints = map[string]string{
+	"bad certificate":                "peer rejected our certificate (does the peer trust our CA and expect this name?)",
+	"expired certificate":            "peer says our certificate has expired",
+	"unknown certificate authority":  "peer does not trust the CA that signed our certificate",
+	"certificate required":           "peer requires a client certificate (configure cert_file and key_file)",
+	"protocol version not supported": "no common TLS version (TLS 1.2 or later is required)",
+}
@@ -0,0 +1,201 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultReloadInterval은 인증서 파일 변경을 확인하는 기본 간격입니다
+	DefaultReloadInterval = 10 * time.Second
+	// expiryWarning은 인증서 만료가 임박했다고 경고하는 기준입니다
+	expiryWarning = 7 * 24 * time.Hour
+)
+
+// Reloader keeps a certificate, key and CA bundle loaded from files up to date.
+//
+// Reloader는 파일에서 읽은 인증서, 개인 키, CA 번들을 보관하고 파일이 바뀌면 다시 읽습니다.
+// cert-manager처럼 Secret 볼륨의 파일을 교체하는 방식의 인증서 갱신을 재시작 없이 반영합니다.
+// 새 파일을 읽지 못하면 (인증서와 키가 일부만 교체된 경우 등) 이전 값을 유지하고
+// 다음 확인 때 다시 시도합니다.
+type Reloader struct {
+	name     string // 로그에 표시할 이름 (예: "gRPC server")
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu    sync.RWMutex
+	cert  *tls.Certificate // certFile이 없으면 nil
+	pool  *x509.CertPool   // caFile이 없으면 nil
+	stamp string           // 마지막으로 읽은 파일들의 변경 시간과 크기
+}
+
+// NewReloader loads the files once and returns a reloader for them.
+//
+// NewReloader는 파일들을 읽어 Reloader를 생성합니다. 빈 경로는 사용하지 않으며,
+// 인증서와 키는 함께 지정해야 합니다.
+func NewReloader(name, certFile, keyFile, caFile string) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("%s TLS: cert file and key file must be set together", name)
+	}
+
+	r := &Reloader{name: name, certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Run checks the files every interval and reloads them when they change.
+//
+// Run은 interval마다 파일 변경을 확인하고, 바뀌었으면 다시 읽습니다. ctx가 취소되면 끝납니다.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var failedStamp string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stamp, err := r.fileStamp()
+		if err != nil || stamp == r.currentStamp() {
+			continue
+		}
+		if err := r.reload(); err != nil {
+			// 같은 파일 상태에 대한 실패는 한 번만 기록
+			if stamp != failedStamp {
+				log.Printf("⚠️ %s TLS 인증서 다시 읽기 실패, 이전 인증서를 계속 사용합니다: %v", r.name, err)
+				failedStamp = stamp
+			}
+			continue
+		}
+		failedStamp = ""
+	}
+}
+
+// Certificate returns the current certificate (nil when no cert file is set).
+//
+// Certificate는 현재 인증서를 반환합니다 (인증서 파일이 없으면 nil).
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the current CA bundle (nil when no CA file is set).
+//
+// CAPool은 현재 CA 번들을 반환합니다 (CA 파일이 없으면 nil).
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pool
+}
+
+// currentStamp는 마지막으로 읽은 파일 상태를 반환합니다
+func (r *Reloader) currentStamp() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.stamp
+}
+
+// reload는 파일들을 읽어 인증서와 CA 번들을 교체합니다
+func (r *Reloader) reload() error {
+	stamp, err := r.fileStamp()
+	if err != nil {
+		return err
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		loaded, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("%s TLS: failed to load key pair %s / %s: %w", r.name, r.certFile, r.keyFile, err)
+		}
+		if loaded.Leaf == nil && len(loaded.Certificate) > 0 {
+			loaded.Leaf, _ = x509.ParseCertificate(loaded.Certificate[0])
+		}
+		cert = &loaded
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("%s TLS: failed to read CA file: %w", r.name, err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("%s TLS: no PEM certificates found in CA file %s", r.name, r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	reloaded := r.stamp != ""
+	r.cert = cert
+	r.pool = pool
+	r.stamp = stamp
+	r.mu.Unlock()
+
+	r.logCertificate(cert, reloaded)
+	return nil
+}
+
+// logCertificate는 읽은 인증서의 주체와 만료 시간을 기록하고, 만료가 임박하면 경고합니다
+func (r *Reloader) logCertificate(cert *tls.Certificate, reloaded bool) {
+	action := "로드"
+	if reloaded {
+		action = "갱신"
+	}
+	if cert == nil || cert.Leaf == nil {
+		log.Printf("🔒 %s TLS 설정 %s (CA: %s)", r.name, action, valueOrNone(r.caFile))
+		return
+	}
+
+	leaf := cert.Leaf
+	log.Printf("🔒 %s TLS 인증서 %s: %s (만료: %s, CA: %s)",
+		r.name, action, leaf.Subject.String(), leaf.NotAfter.Format(time.RFC3339), valueOrNone(r.caFile))
+
+	if remaining := time.Until(leaf.NotAfter); remaining < expiryWarning {
+		log.Printf("⏰ %s TLS 인증서가 %v 후 만료됩니다", r.name, remaining.Round(time.Minute))
+	}
+}
+
+// fileStamp는 파일들의 변경 시간과 크기로 현재 상태를 나타내는 문자열을 만듭니다.
+// Secret 볼륨은 심볼릭 링크를 교체하므로 os.Stat으로 링크 대상을 확인합니다.
+func (r *Reloader) fileStamp() (string, error) {
+	var parts []string
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", fmt.Errorf("%s TLS: %w", r.name, err)
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d:%d", path, info.ModTime().UnixNano(), info.Size()))
+	}
+	return strings.Join(parts, "|"), nil
+}
+
+// valueOrNone은 빈 값을 "none"으로 표시합니다
+func valueOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
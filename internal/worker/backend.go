@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+)
+
+// WorkerBackend runs worker processes on behalf of the Manager.
+//
+// WorkerBackend는 Worker를 실제로 실행하는 환경을 추상화합니다.
+// Worker의 상태는 백엔드와 관계없이 v1.Pod로 표현되므로
+// 상태 조회, 라벨 필터링, gRPC 응답 변환 코드는 백엔드를 구분하지 않습니다.
+//
+// 구현체:
+//   - KubernetesBackend: Worker마다 Pod 생성 (기본값)
+//   - LocalBackend: Worker를 로컬 OS 프로세스로 실행 (클러스터 없는 개발용)
+type WorkerBackend interface {
+	// CreateWorker는 Pod 스펙에 따라 Worker를 시작합니다
+	CreateWorker(ctx context.Context, pod *v1.Pod) (*v1.Pod, error)
+	// GetWorker는 Worker의 현재 상태를 반환합니다 (없으면 NotFound 에러)
+	GetWorker(ctx context.Context, name string) (*v1.Pod, error)
+	// WaitForWorker는 Worker가 종료될 때까지 대기하며, 실패한 경우 에러를 반환합니다
+	WaitForWorker(ctx context.Context, name string) error
+	// StreamWorkerLogs는 Worker 컨테이너의 로그를 스트리밍합니다
+	StreamWorkerLogs(ctx context.Context, name string, options k8s.LogStreamOptions) (<-chan k8s.LogEntry, <-chan error)
+	// DeleteWorker는 Worker를 종료하고 정리합니다
+	DeleteWorker(ctx context.Context, name string) error
+	// ListWorkers는 라벨 셀렉터에 맞는 Worker 목록을 반환합니다
+	ListWorkers(ctx context.Context, labelSelector string) ([]v1.Pod, error)
+}
+
+// KubernetesBackend runs each worker as a Kubernetes Pod.
+//
+// KubernetesBackend는 Worker마다 Pod를 생성하는 기본 백엔드입니다.
+type KubernetesBackend struct {
+	client *k8s.Client
+}
+
+// NewKubernetesBackend는 Kubernetes 클라이언트를 사용하는 Worker 백엔드를 생성합니다
+func NewKubernetesBackend(client *k8s.Client) *KubernetesBackend {
+	return &KubernetesBackend{client: client}
+}
+
+// CreateWorker는 Worker Pod를 생성합니다
+func (b *KubernetesBackend) CreateWorker(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
+	return b.client.CreatePod(ctx, pod)
+}
+
+// GetWorker는 Worker Pod를 조회합니다
+func (b *KubernetesBackend) GetWorker(ctx context.Context, name string) (*v1.Pod, error) {
+	return b.client.GetPod(ctx, name)
+}
+
+// WaitForWorker는 Pod가 완료될 때까지 대기합니다.
+//
+// 모니터링 방식:
+//   - 2초 간격으로 Pod 상태 폴링
+//   - Succeeded: 정상 완료
+//   - Failed: 실패
+//   - Running/Pending: 계속 대기
+//
+// Context 취소 시 즉시 반환합니다.
+func (b *KubernetesBackend) WaitForWorker(ctx context.Context, podName string) error {
+	log.Printf("⏳ Waiting for pod %s to complete...", podName)
+
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+
+	startTime := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("⏰ Pod monitoring cancelled for %s after %v", podName, time.Since(startTime))
+			return ctx.Err()
+
+		case <-ticker.C:
+			pod, err := b.client.GetPod(ctx, podName)
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("pod %s no longer exists", podName)
+			}
+			if err != nil {
+				log.Printf("⚠️ Error getting pod %s: %v", podName, err)
+				continue
+			}
+
+			// 상태별 처리
+			switch pod.Status.Phase {
+			case v1.PodSucceeded:
+				duration := time.Since(startTime)
+				log.Printf("✅ Pod %s completed successfully in %v", podName, duration)
+				return nil
+
+			case v1.PodFailed:
+				duration := time.Since(startTime)
+				reason := podFailureReason(pod)
+				log.Printf("❌ Pod %s failed after %v: %s", podName, duration, reason)
+				return fmt.Errorf("pod %s failed: %s", podName, reason)
+
+			case v1.PodRunning:
+				log.Printf("🏃 Pod %s is running... (elapsed: %v)", podName, time.Since(startTime))
+
+			case v1.PodPending:
+				log.Printf("⏸️ Pod %s is pending... (elapsed: %v)", podName, time.Since(startTime))
+
+			default:
+				log.Printf("❓ Pod %s in unknown state: %s", podName, pod.Status.Phase)
+			}
+		}
+	}
+}
+
+// StreamWorkerLogs는 Pod 로그를 스트리밍합니다
+func (b *KubernetesBackend) StreamWorkerLogs(ctx context.Context, name string, options k8s.LogStreamOptions) (<-chan k8s.LogEntry, <-chan error) {
+	return b.client.StreamPodLogs(ctx, name, options)
+}
+
+// DeleteWorker는 Worker Pod를 삭제합니다
+func (b *KubernetesBackend) DeleteWorker(ctx context.Context, name string) error {
+	return b.client.DeletePod(ctx, name)
+}
+
+// ListWorkers는 라벨 셀렉터에 맞는 Pod 목록을 조회합니다
+func (b *KubernetesBackend) ListWorkers(ctx context.Context, labelSelector string) ([]v1.Pod, error) {
+	podList, err := b.client.ListPods(ctx, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// podFailureReason은 Pod 실패 원인을 분석하여 반환합니다
+func podFailureReason(pod *v1.Pod) string {
+	// 컨테이너 상태 확인
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Terminated != nil {
+			terminated := containerStatus.State.Terminated
+			if terminated.ExitCode != 0 {
+				return fmt.Sprintf("container exited with code %d: %s",
+					terminated.ExitCode, terminated.Reason)
+			}
+		}
+
+		if containerStatus.State.Waiting != nil {
+			waiting := containerStatus.State.Waiting
+			return fmt.Sprintf("container waiting: %s - %s",
+				waiting.Reason, waiting.Message)
+		}
+	}
+
+	// Pod 조건 확인
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady && condition.Status == v1.ConditionFalse {
+			return fmt.Sprintf("pod not ready: %s", condition.Message)
+		}
+	}
+
+	return "unknown failure reason"
+}
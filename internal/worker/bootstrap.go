@@ -0,0 +1,151 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/auth"
+)
+
+// BootstrapTokenEnv는 Worker 컨테이너에 부트스트랩 토큰을 전달하는 환경 변수입니다
+const BootstrapTokenEnv = "OTTOSCALER_BOOTSTRAP_TOKEN"
+
+var (
+	// ErrInvalidBootstrapToken은 토큰이 없거나 서명/만료 검사에 실패한 경우입니다
+	ErrInvalidBootstrapToken = errors.New("invalid bootstrap token")
+	// ErrWorkerMismatch는 토큰이 요청한 Worker/Task에 발급되지 않았거나
+	// Ottoscaler가 관리하는 실행 중인 Pod가 아닌 경우입니다
+	ErrWorkerMismatch = errors.New("worker does not match bootstrap token")
+)
+
+// bootstrapState는 부트스트랩 토큰 서명기를 보관합니다
+type bootstrapState struct {
+	signer *auth.BootstrapSigner
+	mu     sync.RWMutex
+}
+
+// SetBootstrapSigner configures the signer used to mint per-worker bootstrap tokens.
+//
+// SetBootstrapSigner는 Worker Pod마다 부트스트랩 토큰을 발급할 서명기를 설정합니다.
+// 설정되면 생성하는 Worker 컨테이너에 OTTOSCALER_BOOTSTRAP_TOKEN 환경 변수가 주입되며,
+// Worker는 RegisterWorker에서 이 토큰으로 자신을 증명합니다. nil을 전달하면 토큰을 주입하지 않습니다.
+func (m *Manager) SetBootstrapSigner(signer *auth.BootstrapSigner) {
+	m.bootstrap.mu.Lock()
+	m.bootstrap.signer = signer
+	m.bootstrap.mu.Unlock()
+}
+
+// bootstrapSigner는 설정된 서명기를 반환합니다 (없으면 nil)
+func (m *Manager) bootstrapSigner() *auth.BootstrapSigner {
+	m.bootstrap.mu.RLock()
+	defer m.bootstrap.mu.RUnlock()
+	return m.bootstrap.signer
+}
+
+// injectBootstrapToken은 claims로 토큰을 발급해 Worker 컨테이너 환경 변수에 설정합니다
+// (이미 있으면 교체). 서명기가 없으면 아무 것도 하지 않습니다.
+func (m *Manager) injectBootstrapToken(pod *v1.Pod, claims auth.BootstrapClaims) error {
+	signer := m.bootstrapSigner()
+	if signer == nil {
+		return nil
+	}
+
+	claims.Namespace = m.namespace
+	token, err := signer.Mint(claims, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Name != WorkerContainerName {
+			continue
+		}
+		for j := range container.Env {
+			if container.Env[j].Name == BootstrapTokenEnv {
+				container.Env[j] = v1.EnvVar{Name: BootstrapTokenEnv, Value: token}
+				return nil
+			}
+		}
+		container.Env = append(container.Env, v1.EnvVar{Name: BootstrapTokenEnv, Value: token})
+		return nil
+	}
+	return fmt.Errorf("pod %s has no %s container", pod.Name, WorkerContainerName)
+}
+
+// VerifyWorker checks that token was minted for workerID and taskID and that the
+// worker is a running pod managed by ottoscaler.
+//
+// VerifyWorker는 RegisterWorker 요청을 검증합니다.
+//   - 토큰 서명과 만료 시간 (실패 시 ErrInvalidBootstrapToken)
+//   - 토큰의 네임스페이스, Pod(또는 Job), Task가 요청과 일치하는지 (실패 시 ErrWorkerMismatch)
+//   - Pod가 존재하고 managed-by=ottoscaler 라벨이 있으며 종료되지 않았는지 (실패 시 ErrWorkerMismatch)
+//
+// Pod 조회 자체가 실패하면 (API 서버 장애 등) 두 에러 어느 것도 아닌 에러를 반환합니다.
+func (m *Manager) VerifyWorker(ctx context.Context, token, workerID, taskID string) error {
+	signer := m.bootstrapSigner()
+	if signer == nil {
+		return errors.New("bootstrap tokens are not configured")
+	}
+	if token == "" {
+		return fmt.Errorf("%w: bootstrap_token is required", ErrInvalidBootstrapToken)
+	}
+
+	claims, err := signer.Verify(token, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidBootstrapToken, err)
+	}
+	if claims.Namespace != m.namespace {
+		return fmt.Errorf("%w: token was issued for namespace %s", ErrWorkerMismatch, claims.Namespace)
+	}
+	if claims.Pod != "" && claims.Pod != workerID {
+		return fmt.Errorf("%w: token was issued for pod %s", ErrWorkerMismatch, claims.Pod)
+	}
+	if claims.TaskID != "" && claims.TaskID != taskID {
+		return fmt.Errorf("%w: token was issued for task %s", ErrWorkerMismatch, claims.TaskID)
+	}
+
+	pod, err := m.workers.GetWorker(ctx, workerID)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: pod %s does not exist", ErrWorkerMismatch, workerID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up pod %s: %w", workerID, err)
+	}
+	if pod.Labels["managed-by"] != "ottoscaler" {
+		return fmt.Errorf("%w: pod %s is not managed by ottoscaler", ErrWorkerMismatch, workerID)
+	}
+	if claims.Job != "" && pod.Labels[batchv1.JobNameLabel] != claims.Job {
+		return fmt.Errorf("%w: pod %s does not belong to job %s", ErrWorkerMismatch, workerID, claims.Job)
+	}
+	if podTaskID := pod.Labels["task-id"]; podTaskID != "" && podTaskID != taskID {
+		return fmt.Errorf("%w: pod %s belongs to task %s", ErrWorkerMismatch, workerID, podTaskID)
+	}
+	if pod.DeletionTimestamp != nil || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return fmt.Errorf("%w: pod %s is no longer running", ErrWorkerMismatch, workerID)
+	}
+
+	log.Printf("🔐 Worker %s 부트스트랩 토큰 확인 (task: %s)", workerID, taskID)
+	return nil
+}
+
+// podBootstrapTokens는 Pod 컨테이너에 주입된 부트스트랩 토큰 값을 반환합니다
+func podBootstrapTokens(pod *v1.Pod) []string {
+	var tokens []string
+	for _, container := range pod.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == BootstrapTokenEnv && env.Value != "" {
+				tokens = append(tokens, env.Value)
+			}
+		}
+	}
+	return tokens
+}
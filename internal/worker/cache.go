@@ -0,0 +1,415 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CacheVolumeName은 Worker Pod에 마운트되는 캐시 볼륨 이름입니다
+	CacheVolumeName = "dependency-cache"
+	// CacheVolumeLabel은 캐시 PVC를 사용하는 Pod에 붙는 라벨입니다 (사용 중 여부 판단용)
+	CacheVolumeLabel = "cache-volume"
+	// CacheHitAnnotation은 Pod가 기존 캐시를 재사용했는지 나타내는 어노테이션입니다
+	CacheHitAnnotation = "ottoscaler.io/cache-hit"
+
+	cacheAppLabel            = "otto-cache"
+	cacheRepositoryAnno      = "ottoscaler.io/repository"
+	cacheKeyAnno             = "ottoscaler.io/cache-key"
+	cacheLastUsedAnno        = "ottoscaler.io/last-used-at"
+	cachePopulatedAnno       = "ottoscaler.io/populated"
+	defaultCacheKey          = "default"
+	defaultCacheSize         = "5Gi"
+	defaultCacheHomeDir      = "/root"
+	defaultCacheMaxVolumes   = 20
+	cacheEvictionListTimeout = 30 * time.Second
+	// cacheEvictionGracePeriod 안에 사용된 PVC는 eviction하지 않음 (ensureCacheVolume 후 Pod 생성 전인 PVC 보호)
+	cacheEvictionGracePeriod = 5 * time.Minute
+)
+
+// CacheSettings configures per-repository dependency cache volumes.
+//
+// CacheSettings는 Repository별 의존성 캐시 볼륨(PVC) 설정입니다.
+// Paths의 각 항목은 하나의 PVC 안에서 subPath로 분리되어 마운트됩니다.
+//
+// 예시:
+//
+//	worker.CacheSettings{
+//		Enabled:    true,
+//		Size:       "10Gi",
+//		MaxVolumes: 20,
+//		Paths:      map[string]string{"gomod": "/go/pkg/mod", "npm": "~/.npm"},
+//	}
+type CacheSettings struct {
+	Enabled      bool              // 캐시 사용 여부
+	StorageClass string            // PVC StorageClass (비어있으면 클러스터 기본값)
+	Size         string            // PVC 크기 제한 (예: "5Gi")
+	AccessMode   string            // ReadWriteMany(기본값) 또는 ReadWriteOnce (한 번에 Worker 하나만 사용)
+	MaxVolumes   int               // 유지할 최대 캐시 PVC 수 (초과 시 LRU 삭제)
+	Paths        map[string]string // subPath 이름 → 마운트 경로 ("~"는 /root로 확장)
+}
+
+// CacheRequest identifies the dependency cache a worker should use.
+//
+// CacheRequest는 Worker가 사용할 캐시를 식별합니다.
+// 같은 Repository와 Key를 가진 Worker들은 같은 PVC를 공유합니다.
+type CacheRequest struct {
+	Repository string `json:"repository"` // Git 저장소 (repository 라벨과 동일한 값)
+	Key        string `json:"key"`        // 캐시 키 (예: go.sum 해시, 비어있으면 "default")
+}
+
+// cacheVolume은 Pod에 마운트할 캐시 PVC 정보입니다
+type cacheVolume struct {
+	ClaimName string
+	Hit       bool
+}
+
+// cacheState는 캐시 설정과 eviction 동기화를 보관합니다
+type cacheState struct {
+	settings  CacheSettings
+	exclusive map[string]string // ReadWriteOnce PVC 이름 → 사용 중인 Worker (Pod 또는 Job 이름)
+	mu        sync.RWMutex
+	evictMu   sync.Mutex
+}
+
+// SetCacheSettings configures dependency cache volumes for Worker Pods.
+//
+// SetCacheSettings는 Worker Pod의 의존성 캐시 설정을 적용합니다.
+func (m *Manager) SetCacheSettings(settings CacheSettings) {
+	if settings.Size == "" {
+		settings.Size = defaultCacheSize
+	}
+	if settings.AccessMode == "" {
+		settings.AccessMode = string(v1.ReadWriteMany)
+	}
+	if settings.MaxVolumes <= 0 {
+		settings.MaxVolumes = defaultCacheMaxVolumes
+	}
+
+	m.cache.mu.Lock()
+	m.cache.settings = settings
+	m.cache.mu.Unlock()
+
+	if settings.Enabled {
+		log.Printf("💾 Dependency cache enabled (size: %s, access mode: %s, max volumes: %d, paths: %d)",
+			settings.Size, settings.AccessMode, settings.MaxVolumes, len(settings.Paths))
+	}
+}
+
+// cacheSettings는 현재 캐시 설정을 반환합니다
+func (m *Manager) cacheSettings() CacheSettings {
+	m.cache.mu.RLock()
+	defer m.cache.mu.RUnlock()
+	return m.cache.settings
+}
+
+// cacheClaimName은 Repository와 캐시 키로부터 결정적인 PVC 이름을 생성합니다
+func cacheClaimName(req *CacheRequest) string {
+	key := req.Key
+	if key == "" {
+		key = defaultCacheKey
+	}
+	sum := sha256.Sum256([]byte(req.Repository + "\x00" + key))
+	return "otto-cache-" + hex.EncodeToString(sum[:])[:16]
+}
+
+// ensureCacheVolume은 캐시 PVC를 조회하거나 필요 시 생성합니다.
+//
+// 이미 Worker가 성공적으로 사용한 PVC를 재사용하는 경우 캐시 히트로 간주합니다.
+// 새로 생성한 경우 최대 개수를 초과한 오래된 캐시 PVC를 LRU 방식으로 정리합니다.
+// ReadWriteOnce PVC는 holder(workers개의 Pod를 실행하는 Pod 또는 Job)에 예약되며,
+// 사용이 끝나면 releaseCacheVolume으로 해제해야 합니다.
+func (m *Manager) ensureCacheVolume(ctx context.Context, req *CacheRequest, holder string, workers int) (*cacheVolume, error) {
+	settings := m.cacheSettings()
+	if !settings.Enabled || req == nil || req.Repository == "" || len(settings.Paths) == 0 {
+		return nil, nil
+	}
+	// 캐시 PVC는 Kubernetes 백엔드에서만 사용 가능
+	if m.k8sClient == nil {
+		return nil, nil
+	}
+
+	claimName := cacheClaimName(req)
+	now := time.Now().Format(time.RFC3339)
+
+	existing, err := m.k8sClient.GetPVC(ctx, claimName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 기존 PVC는 생성 당시의 접근 모드를 따름
+	shared := settings.AccessMode == string(v1.ReadWriteMany)
+	if err == nil {
+		shared = claimSharable(existing)
+	}
+	if !shared {
+		if err := m.reserveCacheVolume(ctx, claimName, holder, workers); err != nil {
+			return nil, err
+		}
+	}
+
+	if err == nil {
+		if err := m.k8sClient.AnnotatePVC(ctx, claimName, map[string]string{cacheLastUsedAnno: now}); err != nil {
+			log.Printf("⚠️ Failed to touch cache volume %s: %v", claimName, err)
+		}
+		return &cacheVolume{
+			ClaimName: claimName,
+			Hit:       existing.Annotations[cachePopulatedAnno] == "true",
+		}, nil
+	}
+
+	size, err := resource.ParseQuantity(settings.Size)
+	if err != nil {
+		m.releaseCacheVolume(claimName, holder)
+		return nil, fmt.Errorf("invalid cache size %q: %w", settings.Size, err)
+	}
+
+	key := req.Key
+	if key == "" {
+		key = defaultCacheKey
+	}
+
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: m.namespace,
+			Labels: map[string]string{
+				"managed-by": "ottoscaler",
+				"app":        cacheAppLabel,
+			},
+			Annotations: map[string]string{
+				cacheRepositoryAnno: req.Repository,
+				cacheKeyAnno:        key,
+				cacheLastUsedAnno:   now,
+			},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.PersistentVolumeAccessMode(settings.AccessMode)},
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: size},
+			},
+		},
+	}
+	if settings.StorageClass != "" {
+		pvc.Spec.StorageClassName = &settings.StorageClass
+	}
+
+	if _, err := m.k8sClient.CreatePVC(ctx, pvc); err != nil {
+		// 같은 Repository의 병렬 Worker가 먼저 생성한 경우
+		if apierrors.IsAlreadyExists(err) {
+			return &cacheVolume{ClaimName: claimName}, nil
+		}
+		m.releaseCacheVolume(claimName, holder)
+		return nil, err
+	}
+
+	log.Printf("💾 Created dependency cache %s for %s (key: %s)", claimName, req.Repository, key)
+
+	go m.evictCacheVolumes(settings.MaxVolumes, claimName)
+
+	return &cacheVolume{ClaimName: claimName}, nil
+}
+
+// claimSharable은 PVC를 여러 노드의 Worker가 동시에 마운트할 수 있는지 반환합니다
+func claimSharable(pvc *v1.PersistentVolumeClaim) bool {
+	for _, mode := range pvc.Spec.AccessModes {
+		if mode == v1.ReadWriteMany {
+			return true
+		}
+	}
+	return false
+}
+
+// reserveCacheVolume reserves a ReadWriteOnce cache volume for a single holder.
+//
+// reserveCacheVolume은 ReadWriteOnce 캐시 PVC를 holder 하나만 사용하도록 예약합니다.
+// ReadWriteOnce PVC는 한 노드에만 연결되므로, 다른 노드에 스케줄된 Worker는 앞선 Worker가
+// 끝날 때까지 Pending 상태로 남습니다. 이를 기다리지 않고 바로 에러를 반환합니다.
+func (m *Manager) reserveCacheVolume(ctx context.Context, claimName, holder string, workers int) error {
+	if workers > 1 {
+		return fmt.Errorf("cache volume %s is %s and cannot be shared by %d parallel workers; set WORKER_CACHE_ACCESS_MODE=%s",
+			claimName, v1.ReadWriteOnce, workers, v1.ReadWriteMany)
+	}
+
+	m.cache.mu.Lock()
+	if owner, ok := m.cache.exclusive[claimName]; ok {
+		m.cache.mu.Unlock()
+		return cacheInUseError(claimName, owner)
+	}
+	if m.cache.exclusive == nil {
+		m.cache.exclusive = make(map[string]string)
+	}
+	m.cache.exclusive[claimName] = holder
+	m.cache.mu.Unlock()
+
+	// 재시작 전에 생성된 Worker가 아직 사용 중인지 확인
+	pods, err := m.k8sClient.ListPods(ctx, "managed-by=ottoscaler,"+CacheVolumeLabel+"="+claimName)
+	if err != nil {
+		m.releaseCacheVolume(claimName, holder)
+		return fmt.Errorf("failed to check users of cache volume %s: %w", claimName, err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == v1.PodPending || pod.Status.Phase == v1.PodRunning {
+			m.releaseCacheVolume(claimName, holder)
+			return cacheInUseError(claimName, pod.Name)
+		}
+	}
+
+	return nil
+}
+
+// releaseCacheVolume은 holder가 예약한 ReadWriteOnce 캐시 PVC를 해제합니다
+func (m *Manager) releaseCacheVolume(claimName, holder string) {
+	if claimName == "" {
+		return
+	}
+
+	m.cache.mu.Lock()
+	defer m.cache.mu.Unlock()
+	if m.cache.exclusive[claimName] == holder {
+		delete(m.cache.exclusive, claimName)
+	}
+}
+
+// cacheInUseError는 ReadWriteOnce 캐시 PVC를 다른 Worker가 사용 중일 때의 에러입니다
+func cacheInUseError(claimName, holder string) error {
+	return fmt.Errorf("cache volume %s is %s and already in use by %s; set WORKER_CACHE_ACCESS_MODE=%s to share caches between parallel workers",
+		claimName, v1.ReadWriteOnce, holder, v1.ReadWriteMany)
+}
+
+// markCachePopulated는 Worker가 성공적으로 완료된 후 캐시를 채워진 상태로 표시합니다
+func (m *Manager) markCachePopulated(ctx context.Context, claimName string) {
+	if err := m.k8sClient.AnnotatePVC(ctx, claimName, map[string]string{
+		cachePopulatedAnno: "true",
+		cacheLastUsedAnno:  time.Now().Format(time.RFC3339),
+	}); err != nil {
+		log.Printf("⚠️ Failed to mark cache volume %s as populated: %v", claimName, err)
+	}
+}
+
+// evictCacheVolumes는 최대 개수를 초과한 캐시 PVC를 가장 오래 사용되지 않은 순서로 삭제합니다.
+//
+// 현재 활성 Pod가 마운트 중인 PVC, 방금 생성한 PVC, 예약된 ReadWriteOnce PVC, 그리고
+// cacheEvictionGracePeriod 안에 사용된 PVC(다른 Worker가 준비했지만 Pod가 아직 없는 경우)는 삭제하지 않습니다.
+func (m *Manager) evictCacheVolumes(maxVolumes int, keep string) {
+	m.cache.evictMu.Lock()
+	defer m.cache.evictMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheEvictionListTimeout)
+	defer cancel()
+
+	pvcList, err := m.k8sClient.ListPVCs(ctx, "managed-by=ottoscaler,app="+cacheAppLabel)
+	if err != nil {
+		log.Printf("⚠️ Cache eviction skipped: %v", err)
+		return
+	}
+
+	excess := len(pvcList.Items) - maxVolumes
+	if excess <= 0 {
+		return
+	}
+
+	inUse := make(map[string]bool)
+	if pods, err := m.k8sClient.ListPods(ctx, "managed-by=ottoscaler,"+CacheVolumeLabel); err == nil {
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == v1.PodPending || pod.Status.Phase == v1.PodRunning {
+				inUse[pod.Labels[CacheVolumeLabel]] = true
+			}
+		}
+	} else {
+		log.Printf("⚠️ Cache eviction skipped: %v", err)
+		return
+	}
+
+	m.cache.mu.RLock()
+	for claimName := range m.cache.exclusive {
+		inUse[claimName] = true
+	}
+	m.cache.mu.RUnlock()
+
+	recent := time.Now().Add(-cacheEvictionGracePeriod)
+	candidates := make([]v1.PersistentVolumeClaim, 0, len(pvcList.Items))
+	for _, pvc := range pvcList.Items {
+		if pvc.Name == keep || inUse[pvc.Name] || cacheLastUsed(pvc).After(recent) {
+			continue
+		}
+		candidates = append(candidates, pvc)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return cacheLastUsed(candidates[i]).Before(cacheLastUsed(candidates[j]))
+	})
+
+	for i := 0; i < excess && i < len(candidates); i++ {
+		pvc := candidates[i]
+		if err := m.k8sClient.DeletePVC(ctx, pvc.Name); err != nil {
+			log.Printf("⚠️ Failed to evict cache volume %s: %v", pvc.Name, err)
+			continue
+		}
+		log.Printf("🧹 Evicted dependency cache %s (%s, last used: %s)",
+			pvc.Name, pvc.Annotations[cacheRepositoryAnno], pvc.Annotations[cacheLastUsedAnno])
+	}
+}
+
+// cacheLastUsed는 캐시 PVC의 마지막 사용 시간을 반환합니다
+func cacheLastUsed(pvc v1.PersistentVolumeClaim) time.Time {
+	if lastUsed, err := time.Parse(time.RFC3339, pvc.Annotations[cacheLastUsedAnno]); err == nil {
+		return lastUsed
+	}
+	return pvc.CreationTimestamp.Time
+}
+
+// applyCacheVolume은 캐시 PVC를 Pod에 마운트하고 캐시 히트 정보를 기록합니다
+func applyCacheVolume(pod *v1.Pod, volume *cacheVolume, paths map[string]string) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: CacheVolumeName,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: volume.ClaimName},
+		},
+	})
+
+	names := make([]string, 0, len(paths))
+	for name := range paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name != WorkerContainerName {
+			continue
+		}
+		for _, name := range names {
+			pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, v1.VolumeMount{
+				Name:      CacheVolumeName,
+				MountPath: expandCachePath(paths[name]),
+				SubPath:   name,
+			})
+		}
+	}
+
+	pod.Labels[CacheVolumeLabel] = volume.ClaimName
+	pod.Annotations[CacheHitAnnotation] = fmt.Sprintf("%t", volume.Hit)
+}
+
+// expandCachePath는 "~"로 시작하는 경로를 컨테이너 홈 디렉토리로 확장합니다
+func expandCachePath(path string) string {
+	if path == "~" {
+		return defaultCacheHomeDir
+	}
+	if strings.HasPrefix(path, "~/") {
+		return defaultCacheHomeDir + path[1:]
+	}
+	return path
+}
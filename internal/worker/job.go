@@ -0,0 +1,515 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/auth"
+)
+
+// Backend selects how Worker Pods are created.
+//
+// Backend는 Worker를 어떤 Kubernetes 리소스로 실행할지 나타냅니다.
+type Backend string
+
+const (
+	// BackendPod는 Worker마다 Pod를 직접 생성합니다 (기본값)
+	BackendPod Backend = "pod"
+	// BackendJob은 Worker들을 batch/v1 Indexed Job으로 생성합니다
+	BackendJob Backend = "job"
+	// BackendLocal은 Worker를 로컬 프로세스로 실행합니다 (LocalBackend와 함께 사용)
+	BackendLocal Backend = "local"
+)
+
+// JobSettings configures the batch/v1 Job backend.
+//
+// JobSettings는 Job 백엔드 설정입니다.
+// 노드 장애(DisruptionTarget)로 인한 Pod 실패는 backoffLimit에 포함되지 않으며,
+// FailJobExitCodes에 해당하는 종료 코드는 재시도 없이 Job을 실패시킵니다.
+type JobSettings struct {
+	BackoffLimit            int32   // 실패 시 재시도 횟수
+	ActiveDeadlineSeconds   int64   // Job 전체 실행 시간 제한 (0이면 제한 없음)
+	TTLSecondsAfterFinished int32   // 완료 후 자동 삭제까지 대기 시간 (0이면 Ottoscaler가 직접 삭제)
+	FailJobExitCodes        []int32 // 재시도 없이 Job을 실패시킬 Worker 종료 코드
+}
+
+// maxJobPodNames는 기억하는 Job Worker 이름 → 실제 Pod 이름 대응의 최대 수입니다 (넘으면 오래된 것부터 버림)
+const maxJobPodNames = 1000
+
+// jobBackendState는 현재 백엔드와 Job 설정을 보관합니다
+type jobBackendState struct {
+	backend  Backend
+	settings JobSettings
+	mu       sync.RWMutex
+
+	// Job 컨트롤러가 정한 실제 Pod 이름 ("<job>-<index>-<hash>", 재시도 시 최신 Pod)
+	podNames map[string]string // Worker 설정 이름 → Pod 이름
+	podOrder []string          // 등록 순서 (오래된 것부터)
+}
+
+// SetBackend configures whether workers run as bare Pods or as batch/v1 Jobs.
+//
+// SetBackend는 Worker 실행 백엔드를 설정합니다.
+func (m *Manager) SetBackend(backend Backend, settings JobSettings) {
+	if backend == "" {
+		backend = BackendPod
+	}
+	if backend == BackendJob && m.k8sClient == nil {
+		log.Printf("⚠️ Job backend requires a Kubernetes client, falling back to per-worker execution")
+		backend = BackendPod
+	}
+
+	m.jobs.mu.Lock()
+	m.jobs.backend = backend
+	m.jobs.settings = settings
+	m.jobs.mu.Unlock()
+
+	if backend == BackendJob {
+		log.Printf("📦 Worker backend: batch/v1 Job (backoffLimit: %d, activeDeadline: %ds, ttl: %ds)",
+			settings.BackoffLimit, settings.ActiveDeadlineSeconds, settings.TTLSecondsAfterFinished)
+	}
+}
+
+// backend는 현재 Worker 실행 백엔드를 반환합니다
+func (m *Manager) backend() Backend {
+	m.jobs.mu.RLock()
+	defer m.jobs.mu.RUnlock()
+	if m.jobs.backend == "" {
+		return BackendPod
+	}
+	return m.jobs.backend
+}
+
+// jobSettings는 현재 Job 설정을 반환합니다
+func (m *Manager) jobSettings() JobSettings {
+	m.jobs.mu.RLock()
+	defer m.jobs.mu.RUnlock()
+	return m.jobs.settings
+}
+
+// PodName returns the actual pod name of a worker, resolving Job-backed workers.
+//
+// PodName은 Worker 설정 이름(ScaleUp 응답, Pipeline Stage의 Worker 이름)에 해당하는 실제 Pod 이름을 반환합니다.
+// Indexed Job으로 실행된 Worker는 완료 인덱스의 (가장 최근) Pod 이름을, 그 외에는 name을 그대로 반환합니다.
+func (m *Manager) PodName(name string) string {
+	m.jobs.mu.RLock()
+	defer m.jobs.mu.RUnlock()
+	if podName, ok := m.jobs.podNames[name]; ok {
+		return podName
+	}
+	return name
+}
+
+// setPodName은 Job Worker 설정 이름에 실제 Pod 이름을 연결합니다
+func (m *Manager) setPodName(name, podName string) {
+	m.jobs.mu.Lock()
+	defer m.jobs.mu.Unlock()
+
+	if m.jobs.podNames == nil {
+		m.jobs.podNames = make(map[string]string)
+	}
+	if _, exists := m.jobs.podNames[name]; !exists {
+		m.jobs.podOrder = append(m.jobs.podOrder, name)
+	}
+	m.jobs.podNames[name] = podName
+
+	for len(m.jobs.podOrder) > maxJobPodNames {
+		delete(m.jobs.podNames, m.jobs.podOrder[0])
+		m.jobs.podOrder = m.jobs.podOrder[1:]
+	}
+}
+
+// CreateWorkerJob creates a single Indexed Job running one completion per worker config.
+//
+// CreateWorkerJob은 Worker 설정들을 하나의 Indexed Job으로 생성합니다.
+// Pod 템플릿은 첫 번째 설정으로 구성되며 (설정들은 이름과 worker-index 라벨만 달라야 함),
+// 각 Pod는 JOB_COMPLETION_INDEX 환경 변수와 완료 인덱스 라벨로 자신의 순번을 알 수 있습니다.
+//
+// 반환되는 Pod는 Job 템플릿에 사용된 최종 Pod 스펙입니다.
+func (m *Manager) CreateWorkerJob(ctx context.Context, configs []WorkerConfig) (*batchv1.Job, *v1.Pod, error) {
+	if len(configs) == 0 {
+		return nil, nil, fmt.Errorf("no worker configurations provided")
+	}
+
+	name := jobName(configs)
+	pod, err := m.preparePodSpec(ctx, configs[0], name, len(configs))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := m.jobSettings()
+	completions := int32(len(configs))
+	completionMode := batchv1.IndexedCompletion
+
+	// Pod 이름은 Job 컨트롤러가 정하므로 부트스트랩 토큰은 Job에 묶음
+	if err := m.injectBootstrapToken(pod, auth.BootstrapClaims{Job: name, TaskID: configs[0].Labels["task-id"]}); err != nil {
+		m.releaseCacheVolume(pod.Labels[CacheVolumeLabel], name)
+		return nil, nil, fmt.Errorf("failed to mint bootstrap token for job %s: %w", name, err)
+	}
+
+	// 인덱스별 값은 Job 컨트롤러가 완료 인덱스 라벨로 부여
+	labels := make(map[string]string, len(pod.Labels))
+	for key, value := range pod.Labels {
+		labels[key] = value
+	}
+	delete(labels, "worker-index")
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       m.namespace,
+			Labels:          labels,
+			Annotations:     pod.Annotations,
+			OwnerReferences: pod.OwnerReferences,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:    &completions,
+			Parallelism:    &completions,
+			CompletionMode: &completionMode,
+			BackoffLimit:   &settings.BackoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+
+	if settings.ActiveDeadlineSeconds > 0 {
+		job.Spec.ActiveDeadlineSeconds = &settings.ActiveDeadlineSeconds
+	}
+	if settings.TTLSecondsAfterFinished > 0 {
+		job.Spec.TTLSecondsAfterFinished = &settings.TTLSecondsAfterFinished
+	}
+	// podFailurePolicy는 restartPolicy: Never인 경우에만 사용 가능
+	if pod.Spec.RestartPolicy == v1.RestartPolicyNever {
+		job.Spec.PodFailurePolicy = buildPodFailurePolicy(settings.FailJobExitCodes)
+	}
+
+	log.Printf("🚀 Creating worker job: %s (image: %s, completions: %d)", name, configs[0].Image, completions)
+
+	createdJob, err := m.k8sClient.CreateJob(ctx, job)
+	if err != nil {
+		m.releaseCacheVolume(pod.Labels[CacheVolumeLabel], name)
+		return nil, nil, fmt.Errorf("failed to create worker job %s: %w", name, err)
+	}
+
+	return createdJob, pod, nil
+}
+
+// buildPodFailurePolicy는 Worker Job의 Pod 실패 정책을 구성합니다.
+//
+// 규칙:
+//  1. 노드 장애, 선점 등 중단(DisruptionTarget)으로 인한 실패는 무시하고 재시도
+//  2. 지정된 종료 코드로 Worker가 종료되면 재시도 없이 Job 실패
+//  3. 그 외의 실패는 backoffLimit까지 재시도
+func buildPodFailurePolicy(failJobExitCodes []int32) *batchv1.PodFailurePolicy {
+	policy := &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{
+			{
+				Action: batchv1.PodFailurePolicyActionIgnore,
+				OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+					{Type: v1.DisruptionTarget, Status: v1.ConditionTrue},
+				},
+			},
+		},
+	}
+
+	if len(failJobExitCodes) > 0 {
+		// API 서버는 정렬되고 중복 없는 종료 코드를 요구
+		codes := append([]int32(nil), failJobExitCodes...)
+		sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+		unique := codes[:0]
+		for i, code := range codes {
+			if i == 0 || code != codes[i-1] {
+				unique = append(unique, code)
+			}
+		}
+
+		containerName := WorkerContainerName
+		policy.Rules = append(policy.Rules, batchv1.PodFailurePolicyRule{
+			Action: batchv1.PodFailurePolicyActionFailJob,
+			OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				ContainerName: &containerName,
+				Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:        unique,
+			},
+		})
+	}
+
+	return policy
+}
+
+// WaitForJobCompletion은 Job이 완료되거나 실패할 때까지 대기합니다.
+//
+// WaitForPodCompletion과 같은 간격으로 Job 상태를 폴링하며,
+// 실패한 경우 Job 컨디션의 사유를 에러로 반환합니다.
+func (m *Manager) WaitForJobCompletion(ctx context.Context, name string) (*batchv1.Job, error) {
+	log.Printf("⏳ Waiting for job %s to complete...", name)
+
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+
+	startTime := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("⏰ Job monitoring cancelled for %s after %v", name, time.Since(startTime))
+			return nil, ctx.Err()
+
+		case <-ticker.C:
+			job, err := m.k8sClient.GetJob(ctx, name)
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("job %s no longer exists", name)
+			}
+			if err != nil {
+				log.Printf("⚠️ Error getting job %s: %v", name, err)
+				continue
+			}
+
+			for _, condition := range job.Status.Conditions {
+				if condition.Status != v1.ConditionTrue {
+					continue
+				}
+				switch condition.Type {
+				case batchv1.JobComplete:
+					log.Printf("✅ Job %s completed successfully in %v", name, time.Since(startTime))
+					return job, nil
+				case batchv1.JobFailed:
+					log.Printf("❌ Job %s failed after %v: %s", name, time.Since(startTime), condition.Reason)
+					return job, fmt.Errorf("job %s failed: %s: %s", name, condition.Reason, condition.Message)
+				}
+			}
+
+			log.Printf("🏃 Job %s: %d active, %d succeeded, %d failed (elapsed: %v)",
+				name, job.Status.Active, job.Status.Succeeded, job.Status.Failed, time.Since(startTime))
+		}
+	}
+}
+
+// runJob은 Worker 설정들을 하나의 Indexed Job으로 실행하고 Worker별 결과를 반환합니다.
+//
+// 전체 프로세스:
+//  1. Job 생성
+//  2. 인덱스별 Pod 추적 (재시도로 새 Pod가 생기면 그 Pod의 로그도 수집)
+//  3. Job 완료 대기
+//  4. 정리 (TTL이 설정되지 않은 경우에만 직접 삭제)
+func (m *Manager) runJob(ctx context.Context, configs []WorkerConfig) []WorkerStatus {
+	startTime := time.Now()
+	statuses := make([]WorkerStatus, len(configs))
+	for i, config := range configs {
+		statuses[i] = WorkerStatus{Name: config.Name, StartTime: startTime}
+	}
+
+	finish := func(completed map[int]bool, cacheHit bool, jobErr error) []WorkerStatus {
+		endTime := time.Now()
+		for i := range statuses {
+			// 인덱스의 Pod가 생성되었으면 실제 Pod 이름으로 보고
+			statuses[i].Name = m.PodName(configs[i].Name)
+			statuses[i].EndTime = endTime
+			statuses[i].Duration = endTime.Sub(startTime)
+			statuses[i].CacheHit = cacheHit
+			if completed[i] {
+				statuses[i].Status = "succeeded"
+				continue
+			}
+			statuses[i].Status = "failed"
+			statuses[i].Error = jobErr
+			if statuses[i].Error == nil {
+				statuses[i].Error = fmt.Errorf("index %d did not complete", i)
+			}
+		}
+		return statuses
+	}
+
+	// 1. Job 생성
+	job, pod, err := m.CreateWorkerJob(ctx, configs)
+	if err != nil {
+		return finish(nil, false, fmt.Errorf("worker creation failed: %w", err))
+	}
+	cacheHit := pod.Annotations[CacheHitAnnotation] == "true"
+	defer m.releaseCacheVolume(pod.Labels[CacheVolumeLabel], job.Name)
+
+	// 2. 인덱스별 Pod 추적
+	taskID := configs[0].Labels["task-id"]
+	if taskID == "" {
+		taskID = "unknown"
+	}
+
+	// Job 완료 후에는 새 Pod 탐색만 멈추고, 로그 수집은 ctx를 그대로 써서 남은 로그를 끝까지 수집
+	followCtx, cancelFollow := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for index := range configs {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			m.followJobIndex(followCtx, ctx, job.Name, index, configs[index].Name, taskID)
+		}(index)
+	}
+
+	// 3. 완료 대기 (각 인덱스의 FinishLogCollection이 끝난 뒤 정리)
+	finishedJob, err := m.WaitForJobCompletion(ctx, job.Name)
+	cancelFollow()
+	wg.Wait()
+
+	// 4. 정리 (TTL이 설정된 경우 Job 컨트롤러가 삭제)
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if m.jobSettings().TTLSecondsAfterFinished <= 0 || finishedJob == nil {
+		if cleanupErr := m.k8sClient.DeleteJob(cleanupCtx, job.Name); cleanupErr != nil && !apierrors.IsNotFound(cleanupErr) {
+			log.Printf("⚠️ Warning: failed to cleanup job %s: %v", job.Name, cleanupErr)
+		}
+	}
+
+	var completed map[int]bool
+	if finishedJob != nil {
+		completed = parseCompletedIndexes(finishedJob.Status.CompletedIndexes)
+	}
+
+	if err == nil {
+		if claimName := pod.Labels[CacheVolumeLabel]; claimName != "" {
+			m.markCachePopulated(cleanupCtx, claimName)
+		}
+	}
+
+	return finish(completed, cacheHit, err)
+}
+
+// followJobIndex는 Job의 특정 완료 인덱스에 해당하는 Pod들을 순서대로 추적하며
+// 로그를 수집합니다. 재시도로 생성된 새 Pod도 이어서 추적하고, 추적하는 Pod 이름을
+// Worker 설정 이름(workerName)에 연결합니다 (PodName 참고).
+// ctx가 취소되면 새 Pod 탐색을 멈추지만, 로그 수집은 logCtx로 실행되어
+// FinishLogCollection이 남은 로그를 모두 전달할 때까지 기다립니다.
+func (m *Manager) followJobIndex(ctx, logCtx context.Context, jobName string, index int, workerName, taskID string) {
+	selector := fmt.Sprintf("%s=%s,%s=%d", batchv1.JobNameLabel, jobName, batchv1.JobCompletionIndexAnnotation, index)
+	followed := make(map[string]bool)
+
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+
+	for {
+		podList, err := m.k8sClient.ListPods(ctx, selector)
+		if err == nil {
+			pods := podList.Items
+			sort.Slice(pods, func(i, j int) bool {
+				return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+			})
+
+			for _, pod := range pods {
+				if followed[pod.Name] {
+					continue
+				}
+				followed[pod.Name] = true
+				m.setPodName(workerName, pod.Name)
+
+				if err := m.logCollector.StartLogCollection(logCtx, pod.Name, taskID); err != nil {
+					log.Printf("⚠️ Warning: failed to start log collection for %s: %v", pod.Name, err)
+				}
+				if err := m.WaitForPodCompletion(ctx, pod.Name); err != nil && ctx.Err() == nil {
+					log.Printf("🔄 Job %s index %d pod %s: %v", jobName, index, pod.Name, err)
+				}
+				m.logCollector.FinishLogCollection(pod.Name, LogDrainTimeout)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseCompletedIndexes는 Job의 completedIndexes 형식("0-2,4")을 파싱합니다
+func parseCompletedIndexes(value string) map[int]bool {
+	completed := make(map[int]bool)
+	if value == "" {
+		return completed
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			continue
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(last); err != nil {
+				continue
+			}
+		}
+		for i := start; i <= end; i++ {
+			completed[i] = true
+		}
+	}
+	return completed
+}
+
+// jobName은 Worker 설정들로부터 Job 이름을 결정합니다.
+//
+// 여러 Worker의 경우 이름의 공통 접두사(인덱스 접미사 제외)를 사용합니다.
+// Indexed Job의 Pod 호스트 이름은 "<job>-<index>"이므로 그 길이가 63자를 넘지 않도록 합니다.
+func jobName(configs []WorkerConfig) string {
+	name := configs[0].Name
+	if len(configs) > 1 {
+		for _, config := range configs[1:] {
+			for !strings.HasPrefix(config.Name, name) {
+				name = name[:len(name)-1]
+			}
+		}
+		if cut := strings.LastIndex(name, "-"); cut > 0 {
+			name = name[:cut]
+		}
+		// 공통 접두사가 없으면 첫 번째 Worker 이름 사용
+		if name == "" {
+			name = configs[0].Name
+		}
+	}
+
+	maxLen := maxResourceNameLen - len("-"+strconv.Itoa(len(configs)-1))
+	if len(name) > maxLen {
+		name = truncateWithHash(name, name, "", maxLen)
+	}
+	return name
+}
+
+// sameWorkload는 Worker 설정들이 이름과 worker-index 라벨을 제외하고 동일한지 확인합니다.
+// 동일한 경우에만 하나의 Indexed Job으로 실행할 수 있습니다.
+func sameWorkload(configs []WorkerConfig) bool {
+	normalize := func(config WorkerConfig) WorkerConfig {
+		config.Name = ""
+		labels := make(map[string]string, len(config.Labels))
+		for key, value := range config.Labels {
+			if key != "worker-index" {
+				labels[key] = value
+			}
+		}
+		config.Labels = labels
+		return config
+	}
+
+	first := normalize(configs[0])
+	for _, config := range configs[1:] {
+		if !reflect.DeepEqual(first, normalize(config)) {
+			return false
+		}
+	}
+	return true
+}
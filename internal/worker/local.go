@@ -0,0 +1,440 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+)
+
+// localMaxLogLines는 로컬 Worker마다 메모리에 보관하는 최대 로그 라인 수입니다
+const localMaxLogLines = 10000
+
+// LocalBackend runs workers as local OS subprocesses.
+//
+// LocalBackend는 Worker 컨테이너의 Command/Args를 로컬 프로세스로 실행하는 백엔드입니다.
+// Kubernetes 클러스터 없이 ScaleUp, Pipeline, 로그 전달 흐름을 개발 머신에서 확인할 수 있습니다.
+//
+// 제약 사항:
+//   - 이미지는 사용하지 않으며 명령어는 호스트에서 그대로 실행됨
+//   - 서비스 컨테이너(init 컨테이너)와 볼륨은 지원하지 않음
+//   - 환경 변수는 value로 지정된 값만 전달됨 (valueFrom 미지원)
+type LocalBackend struct {
+	workDir string
+
+	workers map[string]*localWorker
+	mu      sync.RWMutex
+}
+
+// localWorker는 실행 중이거나 종료된 로컬 Worker 프로세스입니다
+type localWorker struct {
+	pod  *v1.Pod // 상태 (LocalBackend.mu로 보호)
+	cmd  *exec.Cmd
+	dir  string
+	logs *localLogBuffer
+	done chan struct{}
+}
+
+// NewLocalBackend creates a backend running workers under workDir.
+//
+// NewLocalBackend는 로컬 프로세스 백엔드를 생성합니다.
+// Worker마다 workDir 아래에 임시 디렉토리가 생성되며, 비어있으면 OS 임시 디렉토리를 사용합니다.
+func NewLocalBackend(workDir string) *LocalBackend {
+	if workDir == "" {
+		workDir = os.TempDir()
+	}
+
+	log.Printf("🖥️ Local worker backend initialized (work dir: %s)", workDir)
+
+	return &LocalBackend{
+		workDir: workDir,
+		workers: make(map[string]*localWorker),
+	}
+}
+
+// CreateWorker는 Pod의 Worker 컨테이너 명령어를 로컬 프로세스로 시작합니다
+func (b *LocalBackend) CreateWorker(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
+	container := localWorkerContainer(pod)
+	if container == nil {
+		return nil, fmt.Errorf("pod %s has no containers", pod.Name)
+	}
+
+	argv := append(append([]string{}, container.Command...), container.Args...)
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("pod %s: local backend requires a command (image entrypoints are not available)", pod.Name)
+	}
+	if len(pod.Spec.InitContainers) > 0 {
+		log.Printf("⚠️ Local backend ignores %d init/service containers of %s", len(pod.Spec.InitContainers), pod.Name)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.workers[pod.Name]; exists {
+		return nil, apierrors.NewAlreadyExists(v1.Resource("pods"), pod.Name)
+	}
+
+	dir, err := os.MkdirTemp(b.workDir, pod.Name+"-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create work dir for %s: %w", pod.Name, err)
+	}
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "POD_NAME="+pod.Name, "WORKSPACE="+dir)
+	for _, env := range container.Env {
+		if env.ValueFrom == nil {
+			cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+		}
+	}
+	setProcessGroup(cmd)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to capture stdout of %s: %w", pod.Name, err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to capture stderr of %s: %w", pod.Name, err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to start worker %s: %w", pod.Name, err)
+	}
+
+	hostname, _ := os.Hostname()
+	now := metav1.Now()
+
+	status := pod.DeepCopy()
+	status.UID = "" // Kubernetes 오브젝트가 아님
+	status.CreationTimestamp = now
+	status.Spec.NodeName = hostname
+	status.Spec.InitContainers = nil // 실행하지 않는 컨테이너는 로그 수집 대상에서 제외
+	status.Spec.Containers = []v1.Container{*container}
+	status.Status = v1.PodStatus{
+		Phase:     v1.PodRunning,
+		PodIP:     "127.0.0.1",
+		StartTime: &now,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:  container.Name,
+			Image: container.Image,
+			State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: now}},
+		}},
+	}
+
+	worker := &localWorker{
+		pod:  status,
+		cmd:  cmd,
+		dir:  dir,
+		logs: newLocalLogBuffer(),
+		done: make(chan struct{}),
+	}
+	b.workers[pod.Name] = worker
+
+	go b.run(worker, container.Name, stdout, stderr)
+
+	log.Printf("🆕 Local worker started: %s (pid: %d, dir: %s)", pod.Name, cmd.Process.Pid, dir)
+	return status.DeepCopy(), nil
+}
+
+// run은 프로세스 출력을 수집하고 종료 시 상태를 갱신합니다
+func (b *LocalBackend) run(worker *localWorker, containerName string, stdout, stderr io.Reader) {
+	var wg sync.WaitGroup
+	capture := func(reader io.Reader, source string) {
+		defer wg.Done()
+		lines := k8s.NewLineReader(reader, k8s.MaxLogLineBytes)
+		part := 0
+		for {
+			line, more, err := lines.Next()
+			if err != nil {
+				return
+			}
+			// 긴 라인은 조각마다 순번을 붙임
+			if part > 0 || more {
+				part++
+			}
+			worker.logs.append(k8s.LogEntry{
+				PodName:   worker.pod.Name,
+				Container: containerName,
+				Timestamp: time.Now(),
+				Message:   line,
+				Source:    source,
+				Part:      part,
+				Continued: more,
+			})
+			if !more {
+				part = 0
+			}
+		}
+	}
+
+	wg.Add(2)
+	go capture(stdout, "stdout")
+	go capture(stderr, "stderr")
+	// 파이프를 모두 읽은 후에 Wait를 호출해야 함
+	wg.Wait()
+
+	waitErr := worker.cmd.Wait()
+	exitCode := int32(worker.cmd.ProcessState.ExitCode())
+	finishedAt := metav1.Now()
+
+	b.mu.Lock()
+	terminated := &v1.ContainerStateTerminated{
+		ExitCode:   exitCode,
+		Reason:     "Completed",
+		FinishedAt: finishedAt,
+	}
+	if worker.pod.Status.StartTime != nil {
+		terminated.StartedAt = *worker.pod.Status.StartTime
+	}
+	worker.pod.Status.Phase = v1.PodSucceeded
+	if waitErr != nil {
+		worker.pod.Status.Phase = v1.PodFailed
+		terminated.Reason = "Error"
+		terminated.Message = waitErr.Error()
+	}
+	worker.pod.Status.ContainerStatuses[0].State = v1.ContainerState{Terminated: terminated}
+	b.mu.Unlock()
+
+	worker.logs.close()
+	close(worker.done)
+}
+
+// GetWorker는 로컬 Worker의 현재 상태를 반환합니다
+func (b *LocalBackend) GetWorker(ctx context.Context, name string) (*v1.Pod, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	worker, exists := b.workers[name]
+	if !exists {
+		return nil, apierrors.NewNotFound(v1.Resource("pods"), name)
+	}
+	return worker.pod.DeepCopy(), nil
+}
+
+// WaitForWorker는 로컬 Worker 프로세스가 종료될 때까지 대기합니다
+func (b *LocalBackend) WaitForWorker(ctx context.Context, name string) error {
+	b.mu.RLock()
+	worker, exists := b.workers[name]
+	b.mu.RUnlock()
+	if !exists {
+		return fmt.Errorf("pod %s no longer exists", name)
+	}
+
+	log.Printf("⏳ Waiting for local worker %s to complete...", name)
+	startTime := time.Now()
+
+	select {
+	case <-ctx.Done():
+		log.Printf("⏰ Local worker monitoring cancelled for %s after %v", name, time.Since(startTime))
+		return ctx.Err()
+	case <-worker.done:
+	}
+
+	pod, err := b.GetWorker(ctx, name)
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase == v1.PodFailed {
+		reason := podFailureReason(pod)
+		log.Printf("❌ Local worker %s failed after %v: %s", name, time.Since(startTime), reason)
+		return fmt.Errorf("pod %s failed: %s", name, reason)
+	}
+
+	log.Printf("✅ Local worker %s completed successfully in %v", name, time.Since(startTime))
+	return nil
+}
+
+// StreamWorkerLogs는 캡처된 stdout/stderr를 스트리밍합니다.
+//
+// TailLines, SinceTime, Follow 옵션을 지원합니다.
+// Worker 컨테이너 이외의 컨테이너를 요청하면 에러를 반환합니다.
+func (b *LocalBackend) StreamWorkerLogs(ctx context.Context, name string, options k8s.LogStreamOptions) (<-chan k8s.LogEntry, <-chan error) {
+	logChan := make(chan k8s.LogEntry, 100)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(logChan)
+		defer close(errChan)
+
+		b.mu.RLock()
+		worker, exists := b.workers[name]
+		b.mu.RUnlock()
+		if !exists {
+			errChan <- apierrors.NewNotFound(v1.Resource("pods"), name)
+			return
+		}
+
+		if options.Container != "" && options.Container != worker.pod.Status.ContainerStatuses[0].Name {
+			errChan <- fmt.Errorf("container %s is not available in local worker %s", options.Container, name)
+			return
+		}
+
+		position := worker.logs.start(options.TailLines)
+		for {
+			entries, next, closed, changed := worker.logs.read(position)
+			position = next
+
+			for _, entry := range entries {
+				if options.SinceTime != nil && entry.Timestamp.Before(options.SinceTime.Time) {
+					continue
+				}
+				select {
+				case logChan <- entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if closed || !options.Follow {
+				return
+			}
+
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return logChan, errChan
+}
+
+// DeleteWorker는 로컬 Worker 프로세스를 종료하고 작업 디렉토리를 삭제합니다
+func (b *LocalBackend) DeleteWorker(ctx context.Context, name string) error {
+	b.mu.Lock()
+	worker, exists := b.workers[name]
+	delete(b.workers, name)
+	b.mu.Unlock()
+
+	if !exists {
+		return apierrors.NewNotFound(v1.Resource("pods"), name)
+	}
+
+	select {
+	case <-worker.done:
+	default:
+		killProcessGroup(worker.cmd)
+		<-worker.done
+	}
+
+	if err := os.RemoveAll(worker.dir); err != nil {
+		log.Printf("⚠️ Failed to remove work dir of %s: %v", name, err)
+	}
+
+	log.Printf("🗑️ Local worker 삭제 완료: %s", name)
+	return nil
+}
+
+// ListWorkers는 라벨 셀렉터에 맞는 로컬 Worker 목록을 반환합니다
+func (b *LocalBackend) ListWorkers(ctx context.Context, labelSelector string) ([]v1.Pod, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector '%s': %w", labelSelector, err)
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	pods := make([]v1.Pod, 0, len(b.workers))
+	for _, worker := range b.workers {
+		if selector.Matches(labels.Set(worker.pod.Labels)) {
+			pods = append(pods, *worker.pod.DeepCopy())
+		}
+	}
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods, nil
+}
+
+// localWorkerContainer는 로컬로 실행할 Worker 컨테이너를 찾습니다
+func localWorkerContainer(pod *v1.Pod) *v1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == WorkerContainerName {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return &pod.Spec.Containers[0]
+	}
+	return nil
+}
+
+// localLogBuffer는 로컬 Worker의 로그를 보관하고 follow 중인 reader에게 알립니다
+type localLogBuffer struct {
+	entries []k8s.LogEntry
+	dropped int // 최대 라인 수를 넘어 버려진 앞부분 라인 수
+	closed  bool
+	changed chan struct{}
+	mu      sync.Mutex
+}
+
+func newLocalLogBuffer() *localLogBuffer {
+	return &localLogBuffer{changed: make(chan struct{})}
+}
+
+// append는 로그 라인을 추가하고 대기 중인 reader를 깨웁니다
+func (l *localLogBuffer) append(entry k8s.LogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > localMaxLogLines {
+		overflow := len(l.entries) - localMaxLogLines
+		l.entries = append([]k8s.LogEntry(nil), l.entries[overflow:]...)
+		l.dropped += overflow
+	}
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// close는 더 이상 로그가 추가되지 않음을 표시합니다
+func (l *localLogBuffer) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// start는 tailLines 옵션에 따른 읽기 시작 위치를 반환합니다
+func (l *localLogBuffer) start(tailLines *int64) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	end := l.dropped + len(l.entries)
+	if tailLines != nil && int(*tailLines) < len(l.entries) {
+		return end - int(*tailLines)
+	}
+	return l.dropped
+}
+
+// read는 position 이후의 로그와 다음 위치, 종료 여부, 변경 알림 채널을 반환합니다
+func (l *localLogBuffer) read(position int) ([]k8s.LogEntry, int, bool, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if position < l.dropped {
+		position = l.dropped
+	}
+	entries := append([]k8s.LogEntry(nil), l.entries[position-l.dropped:]...)
+	return entries, l.dropped + len(l.entries), l.closed, l.changed
+}
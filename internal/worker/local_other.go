@@ -0,0 +1,15 @@
+//go:build !unix
+
+package worker
+
+import "os/exec"
+
+// setProcessGroup은 프로세스 그룹을 지원하지 않는 플랫폼에서는 아무것도 하지 않습니다
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup은 Worker 프로세스를 종료합니다 (자식 프로세스는 종료되지 않을 수 있음)
+func killProcessGroup(cmd *exec.Cmd) {
+	if cmd.Process != nil {
+		_ = cmd.Process.Kill()
+	}
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/archive"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/search"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// archiveUploadTimeout은 Worker 로그 하나를 보관소에 업로드하는 최대 시간입니다
+const archiveUploadTimeout = 2 * time.Minute
+
+// logOutput은 수집된 로그가 전달되는 대상들입니다
+type logOutput struct {
+	sink    *logSink        // Otto-handler 전달 (nil이면 로컬 출력)
+	archive *archive.Writer // 로그 보관 (nil이면 보관하지 않음)
+	index   *search.Writer  // 검색 색인 (nil이면 색인하지 않음)
+}
+
+// SetLogArchive configures durable storage of every worker's full log.
+//
+// SetLogArchive는 Worker 로그를 보관할 아카이브를 설정합니다.
+// 설정되면 Worker가 삭제된 뒤에도 GetWorkerLogs로 로그를 조회할 수 있습니다.
+// nil을 전달하면 보관을 끕니다.
+func (m *Manager) SetLogArchive(logArchive *archive.Archive) {
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.archive = logArchive
+	lc.logMutex.Unlock()
+}
+
+// LogArchive는 설정된 로그 아카이브를 반환합니다 (없으면 nil)
+func (m *Manager) LogArchive() *archive.Archive {
+	lc := m.logCollector
+	lc.logMutex.RLock()
+	defer lc.logMutex.RUnlock()
+	return lc.archive
+}
+
+// newArchiveWriter는 Pod 라벨로 Task/Pipeline/Stage 인덱스를 채운 아카이브 Writer를 생성합니다
+func (lc *LogCollector) newArchiveWriter(ctx context.Context, logArchive *archive.Archive, podName, taskID string) *archive.Writer {
+	manifest := archive.Manifest{
+		WorkerID:  podName,
+		Namespace: lc.namespace,
+	}
+	if taskID != "unknown" {
+		manifest.TaskID = taskID
+	}
+
+	if pod, err := lc.workers.GetWorker(ctx, podName); err == nil {
+		if pod.Namespace != "" {
+			manifest.Namespace = pod.Namespace
+		}
+		manifest.PipelineID = pod.Labels["pipeline-id"]
+		manifest.StageID = pod.Labels["stage-id"]
+		manifest.Labels = pod.Labels
+	}
+
+	writer, err := logArchive.NewWriter(manifest)
+	if err != nil {
+		log.Printf("⚠️ Failed to start log archive for pod %s: %v", podName, err)
+		return nil
+	}
+	return writer
+}
+
+// closeArchiveWriter는 Worker 로그를 보관소에 업로드합니다
+func closeArchiveWriter(writer *archive.Writer) {
+	if writer == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), archiveUploadTimeout)
+	defer cancel()
+
+	manifest, err := writer.Close(ctx)
+	if err != nil {
+		log.Printf("⚠️ Failed to archive worker log: %v", err)
+		return
+	}
+	log.Printf("🗄️ Archived %d log lines of %s (%d bytes compressed)",
+		manifest.Lines, manifest.WorkerID, manifest.CompressedSize)
+}
+
+// archiveEntry는 WorkerLogEntry를 보관용 엔트리로 변환합니다
+func archiveEntry(entry *pb.WorkerLogEntry) archive.Entry {
+	timestamp, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	return archive.Entry{
+		Timestamp: timestamp,
+		Level:     entry.Level,
+		Source:    entry.Source,
+		Message:   entry.Message,
+		Metadata:  entry.Metadata,
+	}
+}
@@ -0,0 +1,352 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// DefaultLogBufferSize는 Pod별 로그 전달 버퍼의 기본 크기(엔트리 수)입니다
+	DefaultLogBufferSize = 1000
+	// LogDrainTimeout은 Worker 종료 후 남은 로그를 수집/전달하기 위해 기다리는 최대 시간입니다
+	LogDrainTimeout = 30 * time.Second
+
+	// logGroupFlushDelay는 여러 줄 로그 블록의 다음 라인을 기다리는 최대 시간입니다
+	logGroupFlushDelay = 500 * time.Millisecond
+)
+
+// LogForwarder delivers collected worker logs to otto-handler.
+//
+// LogForwarder는 수집된 Worker 로그를 Otto-handler로 전달하는 인터페이스입니다.
+// grpc.OttoHandlerClient가 이 인터페이스를 구현합니다.
+//
+// Worker(Pod)마다 StartLogStream → ForwardLogEntry(반복) → CloseLogStream 순서로
+// 호출되며, 한 Worker에 대한 ForwardLogEntry는 동시에 호출되지 않습니다.
+type LogForwarder interface {
+	// StartLogStream은 Worker의 로그 스트림을 엽니다
+	StartLogStream(ctx context.Context, workerID string, taskID string) error
+	// ForwardLogEntry는 로그 엔트리 하나를 전달합니다
+	ForwardLogEntry(ctx context.Context, entry *pb.WorkerLogEntry) error
+	// CloseLogStream은 Worker의 로그 스트림을 닫습니다
+	CloseLogStream(workerID string) error
+}
+
+// LogForwardingSettings configures how collected logs are forwarded.
+//
+// LogForwardingSettings는 수집된 로그의 전달 방식을 설정합니다.
+type LogForwardingSettings struct {
+	Enabled    bool // false이면 로컬 로그로만 출력
+	BufferSize int  // Pod별 전달 버퍼 크기 (0이면 DefaultLogBufferSize)
+}
+
+// SetLogForwarder configures forwarding of collected worker logs.
+//
+// SetLogForwarder는 수집된 Worker 로그를 전달할 대상을 설정합니다.
+// 이미 진행 중인 로그 수집에는 적용되지 않습니다.
+func (m *Manager) SetLogForwarder(forwarder LogForwarder, settings LogForwardingSettings) {
+	if settings.BufferSize <= 0 {
+		settings.BufferSize = DefaultLogBufferSize
+	}
+
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.forwarder = forwarder
+	lc.enableForwarding = settings.Enabled
+	lc.logBufferSize = settings.BufferSize
+	lc.logMutex.Unlock()
+
+	if settings.Enabled && forwarder != nil {
+		log.Printf("📤 Worker log forwarding enabled (buffer: %d entries per pod)", settings.BufferSize)
+	} else {
+		log.Printf("📜 Worker log forwarding disabled, logs are printed locally")
+	}
+}
+
+// SetLogParsers replaces the parser chain used to detect log levels and fields.
+//
+// SetLogParsers는 로그 레벨과 구조화 필드 감지에 사용할 Parser 체인을 교체합니다.
+// 기본값은 logs.DefaultParsers()이며, 인자 없이 호출하면 감지를 끕니다
+// (스택 트레이스 묶기는 항상 수행됩니다).
+func (m *Manager) SetLogParsers(parsers ...logs.Parser) {
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.parsers = parsers
+	lc.logMutex.Unlock()
+}
+
+// LogContentSettings configures how collected log content is rendered and split.
+//
+// LogContentSettings는 수집된 로그 내용의 출력 방식을 설정합니다.
+type LogContentSettings struct {
+	MaxMessageSize int           // 이보다 긴 엔트리는 여러 엔트리로 나눔 (0이면 나누지 않음)
+	ANSI           logs.ANSIMode // ANSI 색상 코드 처리 (keep, strip, html)
+}
+
+// SetLogContent configures ANSI handling and splitting of long collected log lines.
+//
+// SetLogContent는 수집된 로그의 ANSI 색상 코드 처리 방식과 긴 라인 분할 크기를 설정합니다.
+// MaxMessageSize를 넘는 엔트리는 Secret 마스킹 후 여러 엔트리로 나뉘어 Otto-handler로 전달되며,
+// metadata에 chunk(1부터)와 chunk_total이 기록됩니다 (아카이브와 TailLogs는 나누지 않음).
+// 이후 시작되는 로그 수집에 적용됩니다.
+func (m *Manager) SetLogContent(settings LogContentSettings) {
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.content = settings
+	lc.logMutex.Unlock()
+
+	log.Printf("✂️ Worker log content: max %d bytes per entry, ANSI %s", settings.MaxMessageSize, settings.ANSI)
+}
+
+// logSource는 한 컨테이너에서 수집된 로그에 공통으로 붙는 메타데이터입니다
+type logSource struct {
+	taskID      string
+	podMetadata *pb.WorkerMetadata
+	metadata    map[string]string
+
+	// Secret 마스킹 (nil이면 마스킹하지 않음)
+	redactor *logs.Redactor
+	redact   *logs.RedactStream
+
+	// 이보다 긴 엔트리는 split에서 나눔 (0이면 나누지 않음)
+	maxMessageSize int
+	// MaxLogLineBytes를 넘는 라인에서 앞 조각들이 이미 나눈 chunk 수
+	lineChunks int
+
+	// Step Marker 추적 (nil이면 Step을 기록하지 않음)
+	steps *logs.StepTracker
+}
+
+// newLogSource는 Pod 정보로부터 로그 메타데이터를 구성합니다
+func newLogSource(pod *v1.Pod, namespace, containerName, taskID string) *logSource {
+	if pod.Namespace != "" {
+		namespace = pod.Namespace
+	}
+
+	metadata := map[string]string{
+		"container": containerName,
+	}
+	if IsServiceContainer(containerName) {
+		metadata["service"] = ServiceName(containerName)
+	} else if k8s.IsInitContainer(pod, containerName) {
+		metadata["init_container"] = "true"
+	}
+	if attempt := pod.Annotations[AttemptAnnotation]; attempt != "" {
+		metadata["attempt"] = attempt
+	}
+	if owner := pod.Labels[RunOwnerLabel]; owner != "" {
+		metadata["run_owner"] = owner
+	}
+	if pipelineID := pod.Labels["pipeline-id"]; pipelineID != "" {
+		metadata["pipeline_id"] = pipelineID
+	}
+	if stageID := pod.Labels["stage-id"]; stageID != "" {
+		metadata["stage_id"] = stageID
+	}
+
+	labels := make(map[string]string, len(pod.Labels))
+	for key, value := range pod.Labels {
+		labels[key] = value
+	}
+
+	return &logSource{
+		taskID: taskID,
+		podMetadata: &pb.WorkerMetadata{
+			PodName:   pod.Name,
+			Namespace: namespace,
+			NodeName:  pod.Spec.NodeName,
+			CreatedAt: pod.CreationTimestamp.Format(time.RFC3339),
+			Labels:    labels,
+		},
+		metadata: metadata,
+	}
+}
+
+// setRedactor는 이 컨테이너 로그에 적용할 Secret 마스킹을 설정합니다
+func (s *logSource) setRedactor(redactor *logs.Redactor) {
+	s.redactor = redactor
+	s.redact = redactor.Stream()
+}
+
+// convert는 분석된 로그를 Otto-handler로 보낼 WorkerLogEntry로 변환합니다.
+// 파싱으로 추출된 필드는 metadata에 포함되며, 컨테이너 메타데이터와 키가 겹치면
+// 컨테이너 메타데이터가 우선합니다. 메시지와 필드 값의 Secret은 마스킹되고
+// 마스킹한 개수는 metadata의 redactions에 기록됩니다. 엔트리가 Step 안에 있으면
+// metadata의 step에 Step 이름이 기록됩니다.
+func (s *logSource) convert(record logs.Record) *pb.WorkerLogEntry {
+	entry := record.Entry
+
+	message, redactions := s.redact.Redact(entry.Message)
+
+	var mark logs.StepMark
+	inStep := false
+	if s.steps != nil && entry.Marker == "" {
+		mark, inStep = s.steps.Observe(message, entry.Timestamp)
+	}
+
+	metadata := s.metadata
+	if len(record.Fields) > 0 || redactions > 0 || inStep {
+		metadata = make(map[string]string, len(record.Fields)+len(s.metadata)+1)
+		for key, value := range record.Fields {
+			value, n := s.redactor.Redact(value)
+			metadata[key] = value
+			redactions += n
+		}
+		for key, value := range s.metadata {
+			metadata[key] = value
+		}
+		if redactions > 0 {
+			metadata["redactions"] = strconv.Itoa(redactions)
+		}
+		if inStep {
+			stampStep(metadata, mark)
+		}
+	}
+
+	timestamp := entry.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	return &pb.WorkerLogEntry{
+		WorkerId:    s.podMetadata.PodName,
+		TaskId:      s.taskID,
+		Timestamp:   timestamp.Format(time.RFC3339Nano),
+		Level:       record.Level,
+		Source:      entry.Source,
+		Message:     message,
+		PodMetadata: s.podMetadata,
+		Metadata:    metadata,
+	}
+}
+
+// split은 maxMessageSize를 넘는 엔트리를 chunk, chunk_total 메타데이터가 붙은 여러 엔트리로 나눕니다.
+//
+// MaxLogLineBytes를 넘어 조각(metadata의 part)으로 읽힌 라인은 조각 사이에서 chunk 번호를 이어가며,
+// 전체 개수는 마지막 조각에서야 알 수 있으므로 continued가 붙은 조각의 chunk에는 chunk_total이 없습니다.
+func (s *logSource) split(entry *pb.WorkerLogEntry) []*pb.WorkerLogEntry {
+	chunks := logs.SplitMessage(entry.Message, s.maxMessageSize)
+	continued := entry.Metadata["continued"] == "true"
+	if len(chunks) <= 1 && !continued && s.lineChunks == 0 {
+		return []*pb.WorkerLogEntry{entry}
+	}
+
+	first := s.lineChunks
+	if continued {
+		s.lineChunks += len(chunks)
+	} else {
+		s.lineChunks = 0
+	}
+
+	entries := make([]*pb.WorkerLogEntry, len(chunks))
+	for i, chunk := range chunks {
+		metadata := make(map[string]string, len(entry.Metadata)+2)
+		for key, value := range entry.Metadata {
+			metadata[key] = value
+		}
+		metadata["chunk"] = strconv.Itoa(first + i + 1)
+		if !continued {
+			metadata["chunk_total"] = strconv.Itoa(first + len(chunks))
+		}
+
+		part := proto.Clone(entry).(*pb.WorkerLogEntry)
+		part.Message = chunk
+		part.Metadata = metadata
+		entries[i] = part
+	}
+	return entries
+}
+
+// logSink는 Pod 하나의 로그를 버퍼링하여 순서대로 LogForwarder로 전달합니다.
+// 버퍼가 가득 차면 push가 블로킹되어 로그 스트림 읽기 속도를 늦춥니다.
+type logSink struct {
+	forwarder LogForwarder
+	podName   string
+	entries   chan *pb.WorkerLogEntry
+	done      chan struct{}
+	stop      context.CancelFunc
+
+	forwarded int64
+	failed    int64
+}
+
+// newLogSink는 Worker의 로그 스트림을 열고 전달 고루틴을 시작합니다.
+// 수집이 중단(ctx 취소)되어도 버퍼에 남은 로그는 close 시점까지 전달됩니다.
+func newLogSink(ctx context.Context, forwarder LogForwarder, podName, taskID string, bufferSize int) *logSink {
+	forwardCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+
+	sink := &logSink{
+		forwarder: forwarder,
+		podName:   podName,
+		entries:   make(chan *pb.WorkerLogEntry, bufferSize),
+		done:      make(chan struct{}),
+		stop:      stop,
+	}
+
+	if err := forwarder.StartLogStream(forwardCtx, podName, taskID); err != nil {
+		log.Printf("⚠️ Failed to start log stream for pod %s: %v", podName, err)
+	}
+
+	go sink.run(forwardCtx)
+	return sink
+}
+
+// run은 버퍼의 로그를 순서대로 전달합니다
+func (s *logSink) run(ctx context.Context) {
+	defer close(s.done)
+
+	for entry := range s.entries {
+		if err := s.forwarder.ForwardLogEntry(ctx, entry); err != nil {
+			if s.failed == 0 {
+				log.Printf("⚠️ Failed to forward logs of pod %s: %v", s.podName, err)
+			}
+			s.failed++
+			continue
+		}
+		s.forwarded++
+	}
+}
+
+// push는 로그 엔트리를 전달 버퍼에 넣습니다
+func (s *logSink) push(ctx context.Context, entry *pb.WorkerLogEntry) {
+	select {
+	case s.entries <- entry:
+		return
+	default:
+	}
+
+	select {
+	case s.entries <- entry:
+	case <-ctx.Done():
+	}
+}
+
+// close는 남은 로그를 모두 전달한 뒤 로그 스트림을 닫습니다
+func (s *logSink) close() {
+	if s == nil {
+		return
+	}
+
+	close(s.entries)
+	select {
+	case <-s.done:
+	case <-time.After(LogDrainTimeout):
+		log.Printf("⏰ Log forwarding of pod %s did not drain within %v", s.podName, LogDrainTimeout)
+		s.stop()
+		<-s.done
+	}
+
+	if err := s.forwarder.CloseLogStream(s.podName); err != nil {
+		log.Printf("⚠️ Failed to close log stream for pod %s: %v", s.podName, err)
+	}
+	s.stop()
+	log.Printf("📤 Forwarded %d log entries of pod %s (failed: %d)", s.forwarded, s.podName, s.failed)
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/search"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// SetLogIndex configures the local search index of collected logs.
+//
+// SetLogIndex는 수집한 Worker 로그를 색인할 검색 색인을 설정합니다.
+// 설정되면 실행 중인 Worker와 종료된 Worker의 로그를 SearchLogs로 검색할 수 있습니다.
+// nil을 전달하면 색인을 끕니다.
+func (m *Manager) SetLogIndex(index *search.Index) {
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.index = index
+	lc.logMutex.Unlock()
+}
+
+// LogIndex는 설정된 검색 색인을 반환합니다 (없으면 nil)
+func (m *Manager) LogIndex() *search.Index {
+	lc := m.logCollector
+	lc.logMutex.RLock()
+	defer lc.logMutex.RUnlock()
+	return lc.index
+}
+
+// newIndexWriter는 Pod 라벨로 Pipeline/Stage를 채운 검색 색인 Writer를 생성합니다
+func (lc *LogCollector) newIndexWriter(ctx context.Context, index *search.Index, podName, taskID string) *search.Writer {
+	info := search.WorkerInfo{WorkerID: podName}
+	if taskID != "unknown" {
+		info.TaskID = taskID
+	}
+	if pod, err := lc.workers.GetWorker(ctx, podName); err == nil {
+		info.PipelineID = pod.Labels["pipeline-id"]
+		info.StageID = pod.Labels["stage-id"]
+	}
+
+	writer, err := index.NewWriter(info)
+	if err != nil {
+		log.Printf("⚠️ Failed to start search index for pod %s: %v", podName, err)
+		return nil
+	}
+	return writer
+}
+
+// closeIndexWriter는 기록 중인 검색 세그먼트를 봉인합니다
+func closeIndexWriter(writer *search.Writer) {
+	if writer == nil {
+		return
+	}
+	if err := writer.Close(); err != nil {
+		log.Printf("⚠️ Failed to seal search index segment: %v", err)
+	}
+}
+
+// searchEntry는 WorkerLogEntry를 색인용 엔트리로 변환합니다
+func searchEntry(entry *pb.WorkerLogEntry) search.Entry {
+	timestamp, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	return search.Entry{
+		Timestamp: timestamp,
+		Level:     entry.Level,
+		Source:    entry.Source,
+		Message:   entry.Message,
+	}
+}
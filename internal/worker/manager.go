@@ -37,13 +37,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/Team-5-CodeCat/ottoscaler/internal/archive"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/auth"
 	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/search"
 )
 
 const (
@@ -67,8 +73,17 @@ const (
 //   - 자동 정리 및 리소스 해제
 type Manager struct {
 	k8sClient    *k8s.Client
+	workers      WorkerBackend
 	namespace    string
 	logCollector *LogCollector
+	podTemplate  podTemplateCache
+	cache        cacheState
+	jobs         jobBackendState
+	bootstrap    bootstrapState
+
+	// k8sClient가 없을 때 Task/Pipeline 실행 메타데이터 (부모 ConfigMap Data 대체)
+	localRuns   map[string]map[string]string
+	localRunsMu sync.Mutex
 }
 
 // WorkerConfig contains configuration for creating a Worker Pod.
@@ -82,6 +97,9 @@ type Manager struct {
 //   - Args: 명령어 인자
 //   - Labels: Pod에 적용할 라벨 (관리 및 식별용)
 //   - Resources: CPU/메모리 리소스 제한 (선택적)
+//   - Services: 함께 실행할 서비스 컨테이너 (선택적)
+//   - Cache: 의존성 캐시 볼륨 식별자 (선택적)
+//   - Owner: 부모 Task/Pipeline 오브젝트 (선택적, 삭제 시 Pod도 함께 삭제)
 type WorkerConfig struct {
 	Name      string            `json:"name"`      // Pod 이름
 	Image     string            `json:"image"`     // 컨테이너 이미지
@@ -89,6 +107,9 @@ type WorkerConfig struct {
 	Args      []string          `json:"args"`      // 명령어 인자
 	Labels    map[string]string `json:"labels"`    // Pod 라벨
 	Resources *ResourceConfig   `json:"resources"` // 리소스 설정 (선택적)
+	Services  []ServiceConfig   `json:"services"`  // 서비스 컨테이너 (선택적)
+	Cache     *CacheRequest     `json:"cache"`     // 의존성 캐시 (선택적)
+	Owner     *RunOwner         `json:"-"`         // 부모 오브젝트 (선택적)
 }
 
 // ResourceConfig defines resource limits for Worker Pods.
@@ -111,30 +132,63 @@ type WorkerStatus struct {
 	EndTime   time.Time     `json:"end_time"`   // 종료 시간
 	Duration  time.Duration `json:"duration"`   // 실행 시간
 	Error     error         `json:"error"`      // 에러 (실패 시)
+	CacheHit  bool          `json:"cache_hit"`  // 의존성 캐시 재사용 여부
 }
 
 // LogCollector manages log streaming for worker pods
 //
 // LogCollector는 Worker Pod들의 로그 스트리밍을 관리합니다.
 type LogCollector struct {
-	k8sClient  *k8s.Client
-	activeLogs map[string]context.CancelFunc // Pod별 로그 스트리밍 취소 함수
+	workers    WorkerBackend
+	k8sClient  *k8s.Client // Secret 조회용 (nil이면 Secret 값 마스킹 생략)
+	namespace  string
+	activeLogs map[string]*logCollection // Pod별 로그 수집 상태
 	logMutex   sync.RWMutex
 
+	// 로그 레벨/구조 감지에 사용할 Parser 체인
+	parsers []logs.Parser
+
+	// ANSI 처리와 긴 라인 분할 설정
+	content LogContentSettings
+
+	// Secret 마스킹 설정
+	redaction RedactionSettings
+
+	// 완료된 Worker 로그 보관소 (nil이면 보관하지 않음)
+	archive *archive.Archive
+
+	// 로그 검색 색인 (nil이면 색인하지 않음)
+	index *search.Index
+
+	// Pod별 Step Marker 추적 (완료된 Pod도 일정 수 보관)
+	steps *stepHistory
+
 	// Log forwarding configuration
+	forwarder        LogForwarder
 	enableForwarding bool
 	logBufferSize    int
 }
 
+// logCollection은 Pod 하나의 진행 중인 로그 수집입니다
+type logCollection struct {
+	cancel context.CancelFunc
+	done   chan struct{} // 모든 컨테이너의 로그 수집과 전달이 끝나면 닫힘
+}
+
 // NewLogCollector creates a new log collector
 //
 // NewLogCollector는 새로운 로그 수집기를 생성합니다.
-func NewLogCollector(k8sClient *k8s.Client) *LogCollector {
+func NewLogCollector(workers WorkerBackend, namespace string) *LogCollector {
 	return &LogCollector{
-		k8sClient:        k8sClient,
-		activeLogs:       make(map[string]context.CancelFunc),
+		workers:          workers,
+		namespace:        namespace,
+		activeLogs:       make(map[string]*logCollection),
+		parsers:          logs.DefaultParsers(),
+		content:          LogContentSettings{ANSI: logs.ANSIKeep},
+		redaction:        RedactionSettings{Enabled: true, Patterns: logs.DefaultRedactionPatterns()},
+		steps:            newStepHistory(),
 		enableForwarding: true,
-		logBufferSize:    1000,
+		logBufferSize:    DefaultLogBufferSize,
 	}
 }
 
@@ -147,18 +201,34 @@ func NewLogCollector(k8sClient *k8s.Client) *LogCollector {
 // Returns:
 //   - *Manager: 초기화된 매니저
 func NewManager(k8sClient *k8s.Client, namespace string) *Manager {
+	return NewManagerWithBackend(NewKubernetesBackend(k8sClient), k8sClient, namespace)
+}
+
+// NewManagerWithBackend는 지정된 WorkerBackend로 Worker를 실행하는 Manager를 생성합니다.
+//
+// k8sClient는 nil일 수 있습니다. 이 경우 Kubernetes 오브젝트가 필요한 기능
+// (캐시 PVC, PodTemplate 오브젝트, Job 백엔드)은 비활성화되고,
+// Task/Pipeline 시도 번호는 메모리에서 관리됩니다.
+//
+// 예시 (클러스터 없이 로컬 프로세스로 실행):
+//
+//	manager := worker.NewManagerWithBackend(worker.NewLocalBackend(""), nil, "default")
+func NewManagerWithBackend(workers WorkerBackend, k8sClient *k8s.Client, namespace string) *Manager {
 	if namespace == "" {
 		namespace = "default"
 	}
 
 	log.Printf("👷 Worker Manager initialized for namespace: %s", namespace)
 
-	logCollector := NewLogCollector(k8sClient)
+	logCollector := NewLogCollector(workers, namespace)
+	logCollector.k8sClient = k8sClient
 
 	return &Manager{
 		k8sClient:    k8sClient,
+		workers:      workers,
 		namespace:    namespace,
 		logCollector: logCollector,
+		localRuns:    make(map[string]map[string]string),
 	}
 }
 
@@ -168,20 +238,65 @@ func NewManager(k8sClient *k8s.Client, namespace string) *Manager {
 //   - RestartPolicy: Never (일회성 작업)
 //   - 관리 라벨 자동 추가
 //   - 리소스 제한 적용 (설정된 경우)
+//   - 의존성 캐시 PVC 마운트 (설정된 경우)
+//   - 기본 PodTemplate 병합 (설정된 경우)
+//
+// 병합된 최종 스펙은 생성 전에 검증됩니다.
 func (m *Manager) CreateWorkerPod(ctx context.Context, config WorkerConfig) (*v1.Pod, error) {
-	// Pod 스펙 생성
-	podSpec := m.buildPodSpec(config)
+	podSpec, err := m.preparePodSpec(ctx, config, config.Name, 1)
+	if err != nil {
+		return nil, err
+	}
 
 	log.Printf("🚀 Creating worker pod: %s (image: %s)", config.Name, config.Image)
 
-	createdPod, err := m.k8sClient.CreatePod(ctx, podSpec)
+	createdPod, err := m.workers.CreateWorker(ctx, podSpec)
 	if err != nil {
+		m.releaseCacheVolume(podSpec.Labels[CacheVolumeLabel], config.Name)
 		return nil, fmt.Errorf("failed to create worker pod %s: %w", config.Name, err)
 	}
 
 	return createdPod, nil
 }
 
+// preparePodSpec은 Pod 스펙을 생성하고 캐시 볼륨, 기본 PodTemplate을 적용한 뒤 검증합니다.
+// holder와 workers는 캐시 PVC를 사용할 Pod 또는 Job 이름과 동시에 실행될 Pod 수입니다.
+func (m *Manager) preparePodSpec(ctx context.Context, config WorkerConfig, holder string, workers int) (pod *v1.Pod, err error) {
+	// Pod 스펙 생성
+	podSpec := m.buildPodSpec(config)
+
+	// Worker가 RegisterWorker에서 자신을 증명할 부트스트랩 토큰 주입
+	if err := m.injectBootstrapToken(podSpec, auth.BootstrapClaims{Pod: config.Name, TaskID: config.Labels["task-id"]}); err != nil {
+		return nil, fmt.Errorf("failed to mint bootstrap token for %s: %w", config.Name, err)
+	}
+
+	// 의존성 캐시 볼륨 준비
+	cacheVolume, err := m.ensureCacheVolume(ctx, config.Cache, holder, workers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare cache volume for %s: %w", config.Name, err)
+	}
+	if cacheVolume != nil {
+		applyCacheVolume(podSpec, cacheVolume, m.cacheSettings().Paths)
+		defer func() {
+			if err != nil {
+				m.releaseCacheVolume(cacheVolume.ClaimName, holder)
+			}
+		}()
+	}
+
+	// 기본 PodTemplate 병합
+	podSpec, err = m.applyPodTemplate(ctx, podSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply pod template for %s: %w", config.Name, err)
+	}
+
+	if err := validatePodSpec(podSpec); err != nil {
+		return nil, err
+	}
+
+	return podSpec, nil
+}
+
 // buildPodSpec은 WorkerConfig로부터 Pod 스펙을 구성합니다
 func (m *Manager) buildPodSpec(config WorkerConfig) *v1.Pod {
 	// 기본 라벨 설정
@@ -211,18 +326,41 @@ func (m *Manager) buildPodSpec(config WorkerConfig) *v1.Pod {
 		container.Resources = m.buildResourceRequirements(config.Resources)
 	}
 
+	annotations := map[string]string{
+		"ottoscaler.io/created-at": time.Now().Format(time.RFC3339),
+	}
+
+	// 서비스 컨테이너는 네이티브 사이드카로 Worker보다 먼저 시작
+	if len(config.Services) > 0 {
+		serviceNames := make([]string, len(config.Services))
+		for i, service := range config.Services {
+			serviceNames[i] = service.Name
+		}
+		annotations["ottoscaler.io/services"] = strings.Join(serviceNames, ",")
+	}
+
+	// 부모 Task/Pipeline이 삭제되면 가비지 컬렉터가 Pod도 함께 삭제
+	var ownerReferences []metav1.OwnerReference
+	if config.Owner != nil {
+		if config.Owner.UID != "" {
+			ownerReferences = []metav1.OwnerReference{config.Owner.OwnerReference()}
+		}
+		labels[RunOwnerLabel] = config.Owner.Name
+		annotations[AttemptAnnotation] = strconv.Itoa(config.Owner.Attempt)
+	}
+
 	return &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      config.Name,
-			Namespace: m.namespace,
-			Labels:    labels,
-			Annotations: map[string]string{
-				"ottoscaler.io/created-at": time.Now().Format(time.RFC3339),
-			},
+			Name:            config.Name,
+			Namespace:       m.namespace,
+			Labels:          labels,
+			Annotations:     annotations,
+			OwnerReferences: ownerReferences,
 		},
 		Spec: v1.PodSpec{
-			RestartPolicy: v1.RestartPolicyNever,
-			Containers:    []v1.Container{container},
+			RestartPolicy:  v1.RestartPolicyNever,
+			InitContainers: buildServiceContainers(config.Services),
+			Containers:     []v1.Container{container},
 		},
 	}
 }
@@ -234,96 +372,20 @@ func (m *Manager) buildResourceRequirements(config *ResourceConfig) v1.ResourceR
 	return v1.ResourceRequirements{}
 }
 
-// WaitForPodCompletion은 Pod가 완료될 때까지 대기합니다.
-//
-// 모니터링 방식:
-//   - 2초 간격으로 Pod 상태 폴링
-//   - Succeeded: 정상 완료
-//   - Failed: 실패
-//   - Running/Pending: 계속 대기
+// WaitForPodCompletion은 Worker Pod가 완료될 때까지 대기합니다.
 //
+// 실제 대기 방식은 WorkerBackend에 따라 다릅니다
+// (Kubernetes: 2초 간격 폴링, Local: 프로세스 종료 대기).
 // Context 취소 시 즉시 반환합니다.
 func (m *Manager) WaitForPodCompletion(ctx context.Context, podName string) error {
-	log.Printf("⏳ Waiting for pod %s to complete...", podName)
-
-	ticker := time.NewTicker(PodMonitoringInterval)
-	defer ticker.Stop()
-
-	startTime := time.Now()
-
-	for {
-		select {
-		case <-ctx.Done():
-			log.Printf("⏰ Pod monitoring cancelled for %s after %v", podName, time.Since(startTime))
-			return ctx.Err()
-
-		case <-ticker.C:
-			pod, err := m.k8sClient.GetPod(ctx, podName)
-			if err != nil {
-				log.Printf("⚠️ Error getting pod %s: %v", podName, err)
-				continue
-			}
-
-			// 상태별 처리
-			switch pod.Status.Phase {
-			case v1.PodSucceeded:
-				duration := time.Since(startTime)
-				log.Printf("✅ Pod %s completed successfully in %v", podName, duration)
-				return nil
-
-			case v1.PodFailed:
-				duration := time.Since(startTime)
-				reason := m.getPodFailureReason(pod)
-				log.Printf("❌ Pod %s failed after %v: %s", podName, duration, reason)
-				return fmt.Errorf("pod %s failed: %s", podName, reason)
-
-			case v1.PodRunning:
-				log.Printf("🏃 Pod %s is running... (elapsed: %v)", podName, time.Since(startTime))
-
-			case v1.PodPending:
-				log.Printf("⏸️ Pod %s is pending... (elapsed: %v)", podName, time.Since(startTime))
-
-			default:
-				log.Printf("❓ Pod %s in unknown state: %s", podName, pod.Status.Phase)
-			}
-		}
-	}
-}
-
-// getPodFailureReason은 Pod 실패 원인을 분석하여 반환합니다
-func (m *Manager) getPodFailureReason(pod *v1.Pod) string {
-	// 컨테이너 상태 확인
-	for _, containerStatus := range pod.Status.ContainerStatuses {
-		if containerStatus.State.Terminated != nil {
-			terminated := containerStatus.State.Terminated
-			if terminated.ExitCode != 0 {
-				return fmt.Sprintf("container exited with code %d: %s",
-					terminated.ExitCode, terminated.Reason)
-			}
-		}
-
-		if containerStatus.State.Waiting != nil {
-			waiting := containerStatus.State.Waiting
-			return fmt.Sprintf("container waiting: %s - %s",
-				waiting.Reason, waiting.Message)
-		}
-	}
-
-	// Pod 조건 확인
-	for _, condition := range pod.Status.Conditions {
-		if condition.Type == v1.PodReady && condition.Status == v1.ConditionFalse {
-			return fmt.Sprintf("pod not ready: %s", condition.Message)
-		}
-	}
-
-	return "unknown failure reason"
+	return m.workers.WaitForWorker(ctx, podName)
 }
 
 // CleanupPod는 완료된 Pod를 정리합니다
 func (m *Manager) CleanupPod(ctx context.Context, podName string) error {
 	log.Printf("🧹 Cleaning up pod: %s", podName)
 
-	if err := m.k8sClient.DeletePod(ctx, podName); err != nil {
+	if err := m.workers.DeleteWorker(ctx, podName); err != nil {
 		return fmt.Errorf("failed to cleanup pod %s: %w", podName, err)
 	}
 
@@ -341,13 +403,27 @@ func (m *Manager) CleanupPod(ctx context.Context, podName string) error {
 //
 // 에러 발생 시에도 정리를 시도합니다.
 func (m *Manager) CreateAndWaitForWorker(ctx context.Context, config WorkerConfig) error {
+	_, err := m.runWorker(ctx, config)
+	return err
+}
+
+// runWorker는 CreateAndWaitForWorker의 실제 구현으로, 캐시 히트 여부를 함께 반환합니다
+func (m *Manager) runWorker(ctx context.Context, config WorkerConfig) (cacheHit bool, err error) {
+	// Job 백엔드는 단일 인덱스 Job으로 실행
+	if m.backend() == BackendJob {
+		status := m.runJob(ctx, []WorkerConfig{config})[0]
+		return status.CacheHit, status.Error
+	}
+
 	startTime := time.Now()
 
 	// 1. Worker Pod 생성
-	_, err := m.CreateWorkerPod(ctx, config)
+	pod, err := m.CreateWorkerPod(ctx, config)
 	if err != nil {
-		return fmt.Errorf("worker creation failed: %w", err)
+		return false, fmt.Errorf("worker creation failed: %w", err)
 	}
+	cacheHit = pod.Annotations[CacheHitAnnotation] == "true"
+	defer m.releaseCacheVolume(pod.Labels[CacheVolumeLabel], config.Name)
 
 	// 2. 로그 수집 시작 (taskID 추출)
 	taskID := config.Labels["task-id"]
@@ -362,8 +438,8 @@ func (m *Manager) CreateAndWaitForWorker(ctx context.Context, config WorkerConfi
 	// 3. 완료 대기
 	err = m.WaitForPodCompletion(ctx, config.Name)
 
-	// 4. 로그 수집 중단
-	m.logCollector.StopLogCollection(config.Name)
+	// 4. 남은 로그 수집 및 전달 완료 대기
+	m.logCollector.FinishLogCollection(config.Name, LogDrainTimeout)
 
 	// 5. 정리 (성공/실패 관계없이 수행)
 	cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -377,11 +453,16 @@ func (m *Manager) CreateAndWaitForWorker(ctx context.Context, config WorkerConfi
 
 	if err != nil {
 		log.Printf("❌ Worker %s failed after %v: %v", config.Name, totalDuration, err)
-		return fmt.Errorf("worker %s failed: %w", config.Name, err)
+		return cacheHit, fmt.Errorf("worker %s failed: %w", config.Name, err)
+	}
+
+	// 성공한 Worker가 사용한 캐시는 다음 실행부터 캐시 히트로 취급
+	if claimName := pod.Labels[CacheVolumeLabel]; claimName != "" {
+		m.markCachePopulated(cleanupCtx, claimName)
 	}
 
 	log.Printf("✅ Worker %s completed successfully in %v", config.Name, totalDuration)
-	return nil
+	return cacheHit, nil
 }
 
 // RunMultipleWorkers는 여러 Worker Pod를 동시에 실행하고 모든 완료를 대기합니다.
@@ -391,6 +472,7 @@ func (m *Manager) CreateAndWaitForWorker(ctx context.Context, config WorkerConfi
 //   - 각 Worker는 독립적으로 실행 (하나 실패해도 다른 Worker 계속 실행)
 //   - 모든 Worker 완료 후 전체 결과 반환
 //   - 부분 실패 시에도 상세한 에러 정보 제공
+//   - Job 백엔드에서는 동일한 Worker들을 하나의 Indexed Job으로 실행
 func (m *Manager) RunMultipleWorkers(ctx context.Context, configs []WorkerConfig) error {
 	if len(configs) == 0 {
 		return fmt.Errorf("no worker configurations provided")
@@ -399,48 +481,22 @@ func (m *Manager) RunMultipleWorkers(ctx context.Context, configs []WorkerConfig
 	log.Printf("🚀 Starting batch of %d worker pods", len(configs))
 	startTime := time.Now()
 
-	// 결과 수집용 채널
-	results := make(chan WorkerStatus, len(configs))
-
-	// 모든 Worker를 동시에 시작
-	for _, config := range configs {
-		go func(cfg WorkerConfig) {
-			workerStartTime := time.Now()
-			status := WorkerStatus{
-				Name:      cfg.Name,
-				StartTime: workerStartTime,
-			}
-
-			// Worker 실행
-			err := m.CreateAndWaitForWorker(ctx, cfg)
-
-			// 결과 기록
-			status.EndTime = time.Now()
-			status.Duration = status.EndTime.Sub(status.StartTime)
-			status.Error = err
-
-			if err != nil {
-				status.Status = "failed"
-			} else {
-				status.Status = "succeeded"
-			}
-
-			results <- status
-		}(config)
+	var statuses []WorkerStatus
+	if m.backend() == BackendJob && len(configs) > 1 && sameWorkload(configs) {
+		// 동일한 Worker들은 하나의 Indexed Job으로 실행
+		statuses = m.runJob(ctx, configs)
+	} else {
+		statuses = m.runWorkers(ctx, configs)
 	}
 
-	// 모든 Worker 완료 대기 및 결과 수집
+	// 결과 집계
 	var (
 		successCount = 0
 		failureCount = 0
 		errors       []error
-		statuses     = make([]WorkerStatus, 0, len(configs))
 	)
 
-	for i := 0; i < len(configs); i++ {
-		status := <-results
-		statuses = append(statuses, status)
-
+	for _, status := range statuses {
 		if status.Error != nil {
 			failureCount++
 			errors = append(errors, fmt.Errorf("worker %s: %w", status.Name, status.Error))
@@ -460,7 +516,7 @@ func (m *Manager) RunMultipleWorkers(ctx context.Context, configs []WorkerConfig
 		if status.Error != nil {
 			log.Printf("  ❌ %s: failed in %v", status.Name, status.Duration)
 		} else {
-			log.Printf("  ✅ %s: succeeded in %v", status.Name, status.Duration)
+			log.Printf("  ✅ %s: succeeded in %v (cache hit: %t)", status.Name, status.Duration, status.CacheHit)
 		}
 	}
 
@@ -474,6 +530,48 @@ func (m *Manager) RunMultipleWorkers(ctx context.Context, configs []WorkerConfig
 	return nil
 }
 
+// runWorkers는 각 Worker를 독립적으로 동시에 실행하고 결과를 수집합니다
+func (m *Manager) runWorkers(ctx context.Context, configs []WorkerConfig) []WorkerStatus {
+	// 결과 수집용 채널
+	results := make(chan WorkerStatus, len(configs))
+
+	// 모든 Worker를 동시에 시작
+	for _, config := range configs {
+		go func(cfg WorkerConfig) {
+			workerStartTime := time.Now()
+			status := WorkerStatus{
+				Name:      cfg.Name,
+				StartTime: workerStartTime,
+			}
+
+			// Worker 실행
+			cacheHit, err := m.runWorker(ctx, cfg)
+
+			// 결과 기록
+			status.EndTime = time.Now()
+			status.Duration = status.EndTime.Sub(status.StartTime)
+			status.Error = err
+			status.CacheHit = cacheHit
+
+			if err != nil {
+				status.Status = "failed"
+			} else {
+				status.Status = "succeeded"
+			}
+
+			results <- status
+		}(config)
+	}
+
+	// 모든 Worker 완료 대기 및 결과 수집
+	statuses := make([]WorkerStatus, 0, len(configs))
+	for i := 0; i < len(configs); i++ {
+		statuses = append(statuses, <-results)
+	}
+
+	return statuses
+}
+
 // ListActivePods는 현재 활성 상태인 Worker Pod 목록을 반환합니다.
 //
 // 활성 상태 정의:
@@ -483,15 +581,15 @@ func (m *Manager) RunMultipleWorkers(ctx context.Context, configs []WorkerConfig
 // 이 메서드는 scale_down 기능 구현 시 사용됩니다.
 func (m *Manager) ListActivePods(ctx context.Context) ([]*v1.Pod, error) {
 	// managed-by=ottoscaler 라벨로 필터링
-	podList, err := m.k8sClient.ListPods(ctx, "managed-by=ottoscaler")
+	pods, err := m.workers.ListWorkers(ctx, "managed-by=ottoscaler")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list worker pods: %w", err)
 	}
 
 	activePods := make([]*v1.Pod, 0)
 
-	for i := range podList.Items {
-		pod := &podList.Items[i]
+	for i := range pods {
+		pod := &pods[i]
 
 		// 활성 상태인 Pod만 포함
 		if pod.Status.Phase == v1.PodPending || pod.Status.Phase == v1.PodRunning {
@@ -519,6 +617,11 @@ func (m *Manager) TerminatePods(ctx context.Context, count int) error {
 // StartLogCollection starts log collection for a worker pod
 //
 // StartLogCollection은 Worker Pod의 로그 수집을 시작합니다.
+// Pod spec의 모든 컨테이너(init 컨테이너, 서비스 사이드카, Worker 등)를 시작 순서대로
+// 각각 수집하며, 엔트리에는 컨테이너 이름이 태깅됩니다. 컨테이너가 재시작되면 이전
+// 인스턴스의 로그도 함께 수집합니다. 로그 전달이 설정되어 있으면 수집된 로그를
+// Pod별 버퍼를 거쳐 Otto-handler로 전달합니다. 출력의 Step Marker는 컨테이너별로
+// 추적되어 엔트리 metadata와 WorkerSteps의 Step 요약에 반영됩니다.
 func (lc *LogCollector) StartLogCollection(ctx context.Context, podName string, taskID string) error {
 	lc.logMutex.Lock()
 	defer lc.logMutex.Unlock()
@@ -531,96 +634,284 @@ func (lc *LogCollector) StartLogCollection(ctx context.Context, podName string,
 
 	// Create cancellation context for this pod's log collection
 	logCtx, cancel := context.WithCancel(ctx)
-	lc.activeLogs[podName] = cancel
+	collection := &logCollection{cancel: cancel, done: make(chan struct{})}
+	lc.activeLogs[podName] = collection
+
+	forwarder, bufferSize, parsers, logArchive, index := lc.forwarder, lc.logBufferSize, lc.parsers, lc.archive, lc.index
+	if !lc.enableForwarding {
+		forwarder = nil
+	}
 
 	go func() {
+		out := &logOutput{}
+		if forwarder != nil {
+			out.sink = newLogSink(logCtx, forwarder, podName, taskID, bufferSize)
+		}
+		if logArchive != nil {
+			out.archive = lc.newArchiveWriter(logCtx, logArchive, podName, taskID)
+		}
+		if index != nil {
+			out.index = lc.newIndexWriter(logCtx, index, podName, taskID)
+		}
+
 		defer func() {
+			out.sink.close()
+			closeArchiveWriter(out.archive)
+			closeIndexWriter(out.index)
+			close(collection.done)
+
 			lc.logMutex.Lock()
-			delete(lc.activeLogs, podName)
+			if lc.activeLogs[podName] == collection {
+				delete(lc.activeLogs, podName)
+			}
 			lc.logMutex.Unlock()
 		}()
 
-		// Wait a moment for pod to be ready for log collection
-		select {
-		case <-time.After(5 * time.Second):
-		case <-logCtx.Done():
+		// Start log streaming for every container of the pod in start order
+		pod, err := lc.waitForPod(logCtx, podName)
+		if err != nil {
 			return
 		}
+		containers := podContainers(pod)
+		lc.steps.reset(podName, containers)
+
+		var wg sync.WaitGroup
+		for _, container := range containers {
+			wg.Add(1)
+			go func(containerName string) {
+				defer wg.Done()
+				lc.collectContainerLogs(logCtx, podName, containerName, taskID, parsers, out)
+			}(container)
+		}
+		wg.Wait()
+
+		log.Printf("📜 Log collection completed for pod: %s", podName)
+	}()
+
+	return nil
+}
 
-		// Start log streaming
-		options := k8s.LogStreamOptions{
-			Follow:     true,
-			Timestamps: true,
-			Container:  WorkerContainerName,
+// collectContainerLogs는 단일 컨테이너의 로그를 스트리밍하여 처리합니다
+//
+// 스택 트레이스처럼 여러 줄로 된 로그는 하나의 엔트리로 묶이며, 다음 라인이
+// logGroupFlushDelay 동안 오지 않으면 모은 블록을 내보냅니다.
+func (lc *LogCollector) collectContainerLogs(ctx context.Context, podName, containerName, taskID string, parsers []logs.Parser, out *logOutput) {
+	// 서비스 준비를 기다리는 동안 Worker 컨테이너는 시작되지 않으므로 시작될 때까지 대기
+	pod, err := lc.waitForContainerStart(ctx, podName, containerName)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("⚠️ Container %s of pod %s never started: %v", containerName, podName, err)
 		}
+		return
+	}
+
+	options := k8s.LogStreamOptions{
+		Follow:     true,
+		Timestamps: true,
+		Container:  containerName,
+	}
+
+	lc.logMutex.RLock()
+	content := lc.content
+	lc.logMutex.RUnlock()
+
+	source := newLogSource(pod, lc.namespace, containerName, taskID)
+	source.setRedactor(lc.newRedactor(ctx, pod))
+	source.maxMessageSize = content.MaxMessageSize
+	source.steps = lc.steps.tracker(podName, containerName)
+	defer lc.finishSteps(podName, containerName, source.steps)
+
+	processor := logs.NewProcessor(parsers...).WithANSI(content.ANSI)
+	emit := func(records []logs.Record) {
+		for _, record := range records {
+			lc.processLogEntry(ctx, record, source, out)
+		}
+	}
+	defer func() { emit(processor.Flush()) }()
 
-		logChan, errChan := lc.k8sClient.StreamPodLogs(logCtx, podName, options)
-		log.Printf("📜 Started log collection for pod: %s", podName)
-
-		for {
-			select {
-			case logEntry, ok := <-logChan:
-				if !ok {
-					log.Printf("📜 Log collection completed for pod: %s", podName)
-					return
-				}
-
-				// Process log entry
-				lc.processLogEntry(logEntry, taskID)
-
-			case err, ok := <-errChan:
-				if !ok {
-					return
-				}
-				if err != nil {
-					log.Printf("⚠️ Log collection error for pod %s: %v", podName, err)
-				}
-
-			case <-logCtx.Done():
-				log.Printf("📜 Log collection cancelled for pod: %s", podName)
+	logChan, errChan := lc.workers.StreamWorkerLogs(ctx, podName, options)
+	log.Printf("📜 Started log collection for pod: %s (container: %s)", podName, containerName)
+
+	var flushTimer <-chan time.Time
+	for {
+		select {
+		case logEntry, ok := <-logChan:
+			if !ok {
 				return
 			}
+
+			// Process log entry
+			emit(processor.Process(logEntry))
+			if processor.Pending() && flushTimer == nil {
+				flushTimer = time.After(logGroupFlushDelay)
+			}
+
+		case <-flushTimer:
+			flushTimer = nil
+			emit(processor.Flush())
+
+		case err, ok := <-errChan:
+			if !ok {
+				errChan = nil
+				continue
+			}
+			if err != nil {
+				log.Printf("⚠️ Log collection error for pod %s (container: %s): %v", podName, containerName, err)
+			}
+
+		case <-ctx.Done():
+			log.Printf("📜 Log collection cancelled for pod: %s (container: %s)", podName, containerName)
+			return
 		}
-	}()
+	}
+}
 
-	return nil
+// waitForContainerStart는 컨테이너가 실행(또는 종료) 상태가 될 때까지 대기하고
+// 그 시점의 Pod를 반환합니다
+func (lc *LogCollector) waitForContainerStart(ctx context.Context, podName, containerName string) (*v1.Pod, error) {
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := lc.workers.GetWorker(ctx, podName)
+		if err == nil {
+			if containerStarted(pod, containerName) {
+				return pod, nil
+			}
+			if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+				return nil, fmt.Errorf("pod finished in phase %s", pod.Status.Phase)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForPod는 Pod를 조회할 수 있을 때까지 대기합니다
+func (lc *LogCollector) waitForPod(ctx context.Context, podName string) (*v1.Pod, error) {
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := lc.workers.GetWorker(ctx, podName)
+		if err == nil {
+			return pod, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// podContainers는 Pod spec의 컨테이너 이름을 시작 순서대로 반환합니다
+// (init 컨테이너와 사이드카를 선언 순서대로, 그 다음 일반 컨테이너)
+func podContainers(pod *v1.Pod) []string {
+	containers := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, container := range pod.Spec.InitContainers {
+		containers = append(containers, container.Name)
+	}
+	for _, container := range pod.Spec.Containers {
+		containers = append(containers, container.Name)
+	}
+	return containers
+}
+
+// containerStarted는 Pod 상태에서 컨테이너가 시작되었는지 확인합니다
+func containerStarted(pod *v1.Pod, containerName string) bool {
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...),
+		pod.Status.ContainerStatuses...)
+
+	for _, status := range statuses {
+		if status.Name != containerName {
+			continue
+		}
+		return status.State.Running != nil || status.State.Terminated != nil
+	}
+	return false
 }
 
 // StopLogCollection stops log collection for a worker pod
 //
-// StopLogCollection은 Worker Pod의 로그 수집을 중단합니다.
+// StopLogCollection은 Worker Pod의 로그 수집을 즉시 중단합니다.
+// 이미 버퍼에 쌓인 로그는 계속 전달됩니다.
 func (lc *LogCollector) StopLogCollection(podName string) {
 	lc.logMutex.Lock()
 	defer lc.logMutex.Unlock()
 
-	if cancel, exists := lc.activeLogs[podName]; exists {
-		cancel()
+	if collection, exists := lc.activeLogs[podName]; exists {
+		collection.cancel()
 		delete(lc.activeLogs, podName)
 		log.Printf("🔌 Pod %s의 로그 수집 중단", podName)
 	}
 }
 
+// FinishLogCollection waits for the log collection of a finished pod to drain
+//
+// FinishLogCollection은 종료된 Pod의 남은 로그가 모두 수집되고 전달될 때까지
+// 최대 timeout 동안 기다린 뒤 수집을 중단합니다. Pod를 삭제하기 전에 호출해야
+// 짧게 실행된 Worker의 마지막 로그가 유실되지 않습니다.
+func (lc *LogCollector) FinishLogCollection(podName string, timeout time.Duration) {
+	lc.logMutex.RLock()
+	collection, exists := lc.activeLogs[podName]
+	lc.logMutex.RUnlock()
+
+	if !exists {
+		return
+	}
+
+	select {
+	case <-collection.done:
+	case <-time.After(timeout):
+		log.Printf("⏰ Pod %s의 로그 수집이 %v 안에 끝나지 않아 중단합니다", podName, timeout)
+		lc.StopLogCollection(podName)
+		<-collection.done
+	}
+}
+
 // processLogEntry processes a single log entry from a worker pod
 //
 // processLogEntry는 Worker Pod에서 수집된 로그 엔트리를 처리합니다.
-func (lc *LogCollector) processLogEntry(entry k8s.LogEntry, taskID string) {
-	if !lc.enableForwarding {
+// 로그 보관과 검색 색인이 설정되어 있으면 아카이브와 색인에 기록하고, 로그 전달이 활성화되어 있으면
+// Otto-handler로 보낼 버퍼에 넣으며, 그렇지 않으면 로컬 로그로만 출력합니다.
+func (lc *LogCollector) processLogEntry(ctx context.Context, record logs.Record, source *logSource, out *logOutput) {
+	entry := record.Entry
+	workerLogEntry := source.convert(record)
+
+	if out.archive != nil {
+		// 기록 실패는 Writer에 남아 Close 시점에 보고됨
+		_ = out.archive.Write(archiveEntry(workerLogEntry))
+	}
+	if out.index != nil {
+		if err := out.index.Write(searchEntry(workerLogEntry)); err != nil {
+			log.Printf("⚠️ Failed to index log entry of pod %s: %v", entry.PodName, err)
+		}
+	}
+
+	if out.sink != nil {
+		for _, part := range source.split(workerLogEntry) {
+			out.sink.push(ctx, part)
+		}
 		return
 	}
 
-	// Format log entry for display
-	logLevel := "INFO"
-	if entry.Source == "stderr" {
-		logLevel = "ERROR"
+	// 서비스 컨테이너 로그는 Worker 로그와 구분하여 태깅
+	origin := entry.PodName
+	if IsServiceContainer(entry.Container) {
+		origin = fmt.Sprintf("%s/service:%s", entry.PodName, ServiceName(entry.Container))
 	}
 
-	// TODO: Forward to LogStreamingService for gRPC streaming to otto-handler
-	// For now, just log locally with enhanced formatting
 	log.Printf("📜 [%s|%s] %s: %s",
-		entry.PodName,
-		taskID,
-		logLevel,
-		entry.Message)
+		origin,
+		workerLogEntry.TaskId,
+		workerLogEntry.Level,
+		workerLogEntry.Message)
 }
 
 // GetActiveLogCollections returns the list of pods currently being logged
@@ -644,12 +935,12 @@ func (lc *LogCollector) StopAllLogCollections() {
 	lc.logMutex.Lock()
 	defer lc.logMutex.Unlock()
 
-	for podName, cancel := range lc.activeLogs {
-		cancel()
+	for podName, collection := range lc.activeLogs {
+		collection.cancel()
 		log.Printf("🔌 Pod %s의 로그 수집 중단", podName)
 	}
 
 	// Clear the map
-	lc.activeLogs = make(map[string]context.CancelFunc)
+	lc.activeLogs = make(map[string]*logCollection)
 	log.Printf("🔌 모든 로그 수집 중단됨")
 }
@@ -0,0 +1,258 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// OwnerKindTask는 ScaleUp 요청 단위의 부모 오브젝트 종류입니다
+	OwnerKindTask = "task"
+	// OwnerKindPipeline은 Pipeline 실행 단위의 부모 오브젝트 종류입니다
+	OwnerKindPipeline = "pipeline"
+	// RunOwnerLabel은 Worker Pod가 속한 부모 ConfigMap 이름을 나타내는 라벨입니다
+	RunOwnerLabel = "run-owner"
+	// AttemptAnnotation은 Worker Pod가 생성된 실행 시도 번호입니다
+	AttemptAnnotation = "ottoscaler.io/attempt"
+
+	runOwnerAppLabel   = "otto-run"
+	maxResourceNameLen = 63
+	nameHashLen        = 8
+	ownerUpdateRetries = 5
+)
+
+// RunOwner is the parent object (a ConfigMap) of all worker pods of a task or pipeline.
+//
+// RunOwner는 Task 또는 Pipeline의 모든 Worker Pod가 ownerReference로 가리키는
+// 부모 ConfigMap입니다. 실행 메타데이터와 시도(attempt) 횟수를 보관하며,
+// 삭제하면 가비지 컬렉터가 하위 Worker Pod들을 함께 삭제합니다.
+type RunOwner struct {
+	Kind    string    // OwnerKindTask 또는 OwnerKindPipeline
+	ID      string    // Task ID 또는 Pipeline ID
+	Name    string    // ConfigMap 이름
+	UID     types.UID // ConfigMap UID (ownerReference용)
+	Attempt int       // 현재 실행 시도 번호 (1부터 시작)
+}
+
+// OwnerReference는 Worker Pod에 설정할 ownerReference를 반환합니다
+func (o *RunOwner) OwnerReference() metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       o.Name,
+		UID:        o.UID,
+	}
+}
+
+// RunOwnerName은 Task/Pipeline ID에 대한 부모 ConfigMap 이름을 반환합니다
+func RunOwnerName(kind, id string) string {
+	return truncateWithHash("otto-"+kind+"-"+sanitizeName(id), kind+"/"+id, "", maxResourceNameLen)
+}
+
+// WorkerPodName returns a collision-free worker pod name.
+//
+// WorkerPodName은 충돌하지 않는 Worker Pod 이름을 생성합니다.
+// base의 결정적 해시와 시도 번호, Worker 인덱스를 접미사로 붙이므로
+// 긴 ID가 잘리더라도 서로 다른 base는 다른 이름을 가지며,
+// 같은 Task의 재시도/재실행도 이전 Pod와 충돌하지 않습니다.
+//
+// 예: WorkerPodName("otto-agent-build_123", 2, 1) → "otto-agent-build-123-1a2b3c4d-r2-1"
+func WorkerPodName(base string, attempt, index int) string {
+	return truncateWithHash(sanitizeName(base), base, fmt.Sprintf("-r%d-%d", attempt, index), maxResourceNameLen)
+}
+
+// EnsureRunOwner creates or updates the parent object of a task or pipeline run.
+//
+// EnsureRunOwner는 Task/Pipeline의 부모 ConfigMap을 생성하거나,
+// 이미 존재하면 시도 번호를 1 증가시키고 메타데이터를 갱신합니다.
+func (m *Manager) EnsureRunOwner(ctx context.Context, kind, id string, metadata map[string]string) (*RunOwner, error) {
+	name := RunOwnerName(kind, id)
+	now := time.Now().Format(time.RFC3339)
+
+	// Kubernetes 클라이언트가 없으면 메타데이터와 시도 번호를 메모리에서 관리
+	if m.k8sClient == nil {
+		m.localRunsMu.Lock()
+		attempt, _ := strconv.Atoi(m.localRuns[name]["attempt"])
+		attempt++
+		m.localRuns[name] = runOwnerData(kind, id, attempt, now, metadata)
+		m.localRunsMu.Unlock()
+		return &RunOwner{Kind: kind, ID: id, Name: name, Attempt: attempt}, nil
+	}
+
+	for i := 0; i < ownerUpdateRetries; i++ {
+		existing, err := m.k8sClient.GetConfigMap(ctx, name)
+		if apierrors.IsNotFound(err) {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: m.namespace,
+					Labels: map[string]string{
+						"managed-by": "ottoscaler",
+						"app":        runOwnerAppLabel,
+						"run-kind":   kind,
+					},
+				},
+				Data: runOwnerData(kind, id, 1, now, metadata),
+			}
+
+			created, err := m.k8sClient.CreateConfigMap(ctx, configMap)
+			if apierrors.IsAlreadyExists(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			return newRunOwner(kind, id, created, 1), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		attempt, _ := strconv.Atoi(existing.Data["attempt"])
+		attempt++
+
+		existing.Data = runOwnerData(kind, id, attempt, now, metadata)
+		updated, err := m.k8sClient.UpdateConfigMap(ctx, existing)
+		if apierrors.IsConflict(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return newRunOwner(kind, id, updated, attempt), nil
+	}
+
+	return nil, fmt.Errorf("failed to update run owner %s: too many conflicts", name)
+}
+
+// RunOwnerData returns the run metadata recorded on the parent object of a task or pipeline.
+//
+// RunOwnerData는 부모 ConfigMap에 기록된 실행 메타데이터(repository 등)를 반환합니다.
+// 부모 오브젝트가 없으면 NotFound 에러를 반환합니다.
+func (m *Manager) RunOwnerData(ctx context.Context, kind, id string) (map[string]string, error) {
+	name := RunOwnerName(kind, id)
+
+	if m.k8sClient == nil {
+		m.localRunsMu.Lock()
+		defer m.localRunsMu.Unlock()
+		data, exists := m.localRuns[name]
+		if !exists {
+			return nil, apierrors.NewNotFound(v1.Resource("configmaps"), name)
+		}
+		copied := make(map[string]string, len(data))
+		for key, value := range data {
+			copied[key] = value
+		}
+		return copied, nil
+	}
+
+	configMap, err := m.k8sClient.GetConfigMap(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return configMap.Data, nil
+}
+
+// DeleteRunOwner deletes the parent object of a task or pipeline and cascades to its pods.
+//
+// DeleteRunOwner는 부모 ConfigMap을 삭제합니다. 하위 Worker Pod들은
+// ownerReference에 의해 가비지 컬렉터가 정리하며, 삭제 대상 Pod 이름 목록을 반환합니다.
+func (m *Manager) DeleteRunOwner(ctx context.Context, kind, id string) ([]string, error) {
+	name := RunOwnerName(kind, id)
+
+	pods, err := m.workers.ListWorkers(ctx, fmt.Sprintf("managed-by=ottoscaler,%s=%s", RunOwnerLabel, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of %s: %w", name, err)
+	}
+
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name)
+	}
+
+	if m.k8sClient == nil {
+		// 부모 오브젝트가 없으므로 Worker를 직접 삭제
+		m.localRunsMu.Lock()
+		_, exists := m.localRuns[name]
+		delete(m.localRuns, name)
+		m.localRunsMu.Unlock()
+
+		if !exists {
+			return nil, apierrors.NewNotFound(v1.Resource("configmaps"), name)
+		}
+		for _, podName := range podNames {
+			if err := m.workers.DeleteWorker(ctx, podName); err != nil && !apierrors.IsNotFound(err) {
+				log.Printf("⚠️ Failed to delete worker %s: %v", podName, err)
+			}
+		}
+	} else if err := m.k8sClient.DeleteConfigMap(ctx, name); err != nil {
+		return nil, err
+	}
+
+	log.Printf("🧹 Deleted %s %s (%d worker pods will be garbage collected)", kind, id, len(podNames))
+	return podNames, nil
+}
+
+// newRunOwner는 ConfigMap으로부터 RunOwner를 구성합니다
+func newRunOwner(kind, id string, configMap *v1.ConfigMap, attempt int) *RunOwner {
+	log.Printf("🌳 Run owner %s ready (attempt: %d)", configMap.Name, attempt)
+	return &RunOwner{
+		Kind:    kind,
+		ID:      id,
+		Name:    configMap.Name,
+		UID:     configMap.UID,
+		Attempt: attempt,
+	}
+}
+
+// runOwnerData는 부모 ConfigMap에 저장할 실행 메타데이터를 구성합니다
+func runOwnerData(kind, id string, attempt int, startedAt string, metadata map[string]string) map[string]string {
+	data := make(map[string]string, len(metadata)+4)
+	for key, value := range metadata {
+		data[key] = value
+	}
+	data["kind"] = kind
+	data["id"] = id
+	data["attempt"] = strconv.Itoa(attempt)
+	data["started-at"] = startedAt
+	return data
+}
+
+// sanitizeName은 문자열을 DNS-1123 라벨 규칙에 맞게 정리합니다 (소문자, 숫자, '-')
+func sanitizeName(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+// truncateWithHash는 name 뒤에 hashInput의 결정적 해시와 suffix를 붙이고
+// 전체 길이가 maxLen을 넘지 않도록 name을 잘라냅니다
+func truncateWithHash(name, hashInput, suffix string, maxLen int) string {
+	sum := sha256.Sum256([]byte(hashInput))
+	tail := "-" + hex.EncodeToString(sum[:])[:nameHashLen] + suffix
+
+	if maxLen -= len(tail); len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "-")
+	}
+	if name == "" {
+		name = "otto"
+	}
+	return name + tail
+}
@@ -0,0 +1,119 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+)
+
+// RedactionSettings configures masking of secrets in collected logs.
+//
+// RedactionSettings는 수집된 로그의 Secret 마스킹을 설정합니다.
+// 활성화되면 Worker Pod에 Secret으로 주입된 환경 변수 값과 Patterns에 일치하는
+// 자격 증명이 Otto-handler 전달, 아카이브, TailLogs 전에 마스킹됩니다.
+type RedactionSettings struct {
+	Enabled  bool
+	Patterns []logs.RedactionPattern // 비어있으면 Secret 값만 마스킹
+}
+
+// SetLogRedaction configures secret redaction for collected worker logs.
+//
+// SetLogRedaction은 수집된 Worker 로그의 Secret 마스킹을 설정합니다.
+// 기본값은 logs.DefaultRedactionPatterns()로 활성화된 상태입니다.
+func (m *Manager) SetLogRedaction(settings RedactionSettings) {
+	lc := m.logCollector
+	lc.logMutex.Lock()
+	lc.redaction = settings
+	lc.logMutex.Unlock()
+
+	if settings.Enabled {
+		log.Printf("🙈 Worker log redaction enabled (%d patterns)", len(settings.Patterns))
+	} else {
+		log.Printf("⚠️ Worker log redaction disabled, secrets may appear in forwarded logs")
+	}
+}
+
+// newRedactor는 Pod의 Secret 값과 설정된 패턴으로 Redactor를 생성합니다 (비활성화 시 nil)
+func (lc *LogCollector) newRedactor(ctx context.Context, pod *v1.Pod) *logs.Redactor {
+	lc.logMutex.RLock()
+	settings := lc.redaction
+	lc.logMutex.RUnlock()
+
+	if !settings.Enabled {
+		return nil
+	}
+	return logs.NewRedactor(PodSecretValues(ctx, lc.k8sClient, pod), settings.Patterns)
+}
+
+// PodSecretValues returns the values of Secrets injected into the pod as environment variables.
+//
+// PodSecretValues는 Pod의 컨테이너에 환경 변수로 주입된 Secret 값을 반환합니다.
+// env의 secretKeyRef와 envFrom의 secretRef를 모두 확인하며,
+// 조회할 수 없는 Secret(optional 등)은 건너뜁니다. Ottoscaler가 주입한 부트스트랩 토큰도 포함하며,
+// client가 nil이면 부트스트랩 토큰만 반환합니다.
+func PodSecretValues(ctx context.Context, client *k8s.Client, pod *v1.Pod) []string {
+	if pod == nil {
+		return nil
+	}
+	values := podBootstrapTokens(pod)
+	if client == nil {
+		return values
+	}
+
+	// Secret 이름 → 키 목록 (nil이면 모든 키)
+	refs := make(map[string][]string)
+	allKeys := make(map[string]bool)
+
+	containers := append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+				continue
+			}
+			ref := env.ValueFrom.SecretKeyRef
+			refs[ref.Name] = append(refs[ref.Name], ref.Key)
+		}
+		for _, source := range container.EnvFrom {
+			if source.SecretRef == nil {
+				continue
+			}
+			allKeys[source.SecretRef.Name] = true
+			if _, exists := refs[source.SecretRef.Name]; !exists {
+				refs[source.SecretRef.Name] = nil
+			}
+		}
+	}
+
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		secret, err := client.GetSecret(ctx, name)
+		if err != nil {
+			log.Printf("⚠️ Pod %s의 Secret %s를 읽을 수 없어 마스킹에서 제외합니다: %v", pod.Name, name, err)
+			continue
+		}
+
+		if allKeys[name] {
+			for _, value := range secret.Data {
+				values = append(values, string(value))
+			}
+			continue
+		}
+		for _, key := range refs[name] {
+			if value, ok := secret.Data[key]; ok {
+				values = append(values, string(value))
+			}
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ServiceContainerPrefix는 서비스 컨테이너 이름 접두사입니다
+	ServiceContainerPrefix = "svc-"
+	// ServiceReadinessPeriod는 서비스 준비 상태 확인 간격입니다
+	ServiceReadinessPeriod = 2
+	// ServiceReadinessFailureThreshold는 서비스 준비 대기 최대 횟수입니다 (2초 x 150 = 5분)
+	ServiceReadinessFailureThreshold = 150
+)
+
+// ServiceConfig describes a service container (database, cache) running alongside a worker.
+//
+// ServiceConfig는 Worker와 함께 실행되는 서비스 컨테이너(DB, 캐시 등)를 정의합니다.
+// 서비스는 네이티브 사이드카(restartPolicy: Always인 init 컨테이너)로 실행되며,
+// Worker 컨테이너는 모든 서비스의 준비 확인(startupProbe)이 성공한 후에 시작됩니다.
+type ServiceConfig struct {
+	Name             string            `json:"name"`              // 서비스 이름 (예: "postgres")
+	Image            string            `json:"image"`             // 서비스 이미지
+	Env              map[string]string `json:"env"`               // 환경 변수
+	Ports            []int32           `json:"ports"`             // 컨테이너 포트
+	ReadinessCommand []string          `json:"readiness_command"` // 준비 확인 명령어 (선택적)
+	Command          []string          `json:"command"`           // 실행 명령어 (선택적)
+	Args             []string          `json:"args"`              // 명령어 인자 (선택적)
+}
+
+// ContainerName은 서비스의 컨테이너 이름을 반환합니다
+func (s ServiceConfig) ContainerName() string {
+	return ServiceContainerPrefix + s.Name
+}
+
+// IsServiceContainer는 컨테이너 이름이 서비스 컨테이너인지 확인합니다
+func IsServiceContainer(containerName string) bool {
+	return strings.HasPrefix(containerName, ServiceContainerPrefix)
+}
+
+// ServiceName은 서비스 컨테이너 이름에서 서비스 이름을 추출합니다
+func ServiceName(containerName string) string {
+	return strings.TrimPrefix(containerName, ServiceContainerPrefix)
+}
+
+// buildServiceContainers는 서비스 설정을 네이티브 사이드카 컨테이너로 변환합니다
+func buildServiceContainers(services []ServiceConfig) []v1.Container {
+	containers := make([]v1.Container, 0, len(services))
+	always := v1.ContainerRestartPolicyAlways
+
+	for _, service := range services {
+		container := v1.Container{
+			Name:          service.ContainerName(),
+			Image:         service.Image,
+			Command:       service.Command,
+			Args:          service.Args,
+			Env:           buildEnvVars(service.Env),
+			RestartPolicy: &always,
+		}
+
+		for _, port := range service.Ports {
+			container.Ports = append(container.Ports, v1.ContainerPort{
+				ContainerPort: port,
+				Protocol:      v1.ProtocolTCP,
+			})
+		}
+
+		if handler := buildServiceProbeHandler(service); handler != nil {
+			// startupProbe가 성공해야 다음 컨테이너(Worker)가 시작됨
+			container.StartupProbe = &v1.Probe{
+				ProbeHandler:     *handler,
+				PeriodSeconds:    ServiceReadinessPeriod,
+				FailureThreshold: ServiceReadinessFailureThreshold,
+			}
+			container.ReadinessProbe = &v1.Probe{
+				ProbeHandler:  *handler,
+				PeriodSeconds: ServiceReadinessPeriod * 5,
+			}
+		}
+
+		containers = append(containers, container)
+	}
+
+	return containers
+}
+
+// buildServiceProbeHandler는 서비스 준비 확인 방법을 결정합니다.
+//
+// 우선순위:
+//  1. ReadinessCommand (exec)
+//  2. 첫 번째 포트에 대한 TCP 연결
+//  3. 확인 없음 (컨테이너 시작 즉시 준비로 간주)
+func buildServiceProbeHandler(service ServiceConfig) *v1.ProbeHandler {
+	if len(service.ReadinessCommand) > 0 {
+		return &v1.ProbeHandler{
+			Exec: &v1.ExecAction{Command: service.ReadinessCommand},
+		}
+	}
+
+	if len(service.Ports) > 0 {
+		return &v1.ProbeHandler{
+			TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt32(service.Ports[0])},
+		}
+	}
+
+	return nil
+}
+
+// buildEnvVars는 환경 변수 맵을 정렬된 EnvVar 목록으로 변환합니다
+func buildEnvVars(env map[string]string) []v1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	vars := make([]v1.EnvVar, 0, len(keys))
+	for _, key := range keys {
+		vars = append(vars, v1.EnvVar{Name: key, Value: env[key]})
+	}
+	return vars
+}
@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+const (
+	// maxStepHistoryPods는 Step 요약을 보관하는 최대 Pod 수입니다 (넘으면 오래된 Pod부터 버림)
+	maxStepHistoryPods = 1000
+	// stepFinishTimeout은 컨테이너 종료 코드를 조회하는 최대 시간입니다
+	stepFinishTimeout = 10 * time.Second
+)
+
+// stepHistory는 Pod별, 컨테이너별 StepTracker를 보관합니다.
+// 로그 수집이 끝난 뒤에도 Stage 완료 보고를 위해 maxStepHistoryPods개까지 유지됩니다.
+type stepHistory struct {
+	mu    sync.Mutex
+	pods  map[string]*podSteps
+	order []string // 등록 순서 (오래된 Pod부터)
+}
+
+// podSteps는 Pod 하나의 컨테이너별 StepTracker입니다 (컨테이너 시작 순서)
+type podSteps struct {
+	containers []string
+	trackers   map[string]*logs.StepTracker
+}
+
+// newStepHistory는 빈 stepHistory를 생성합니다
+func newStepHistory() *stepHistory {
+	return &stepHistory{pods: make(map[string]*podSteps)}
+}
+
+// reset은 Pod의 Step 기록을 컨테이너 목록으로 새로 시작합니다
+func (h *stepHistory) reset(podName string, containers []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.pods[podName]; !exists {
+		h.order = append(h.order, podName)
+	}
+	h.pods[podName] = &podSteps{
+		containers: containers,
+		trackers:   make(map[string]*logs.StepTracker, len(containers)),
+	}
+
+	for len(h.order) > maxStepHistoryPods {
+		delete(h.pods, h.order[0])
+		h.order = h.order[1:]
+	}
+}
+
+// tracker는 컨테이너의 StepTracker를 반환합니다 (없으면 생성)
+func (h *stepHistory) tracker(podName, containerName string) *logs.StepTracker {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	pod, exists := h.pods[podName]
+	if !exists {
+		// reset 없이 수집된 경우 (기록 한도로 이미 버려진 Pod 등) 보관하지 않음
+		return logs.NewStepTracker()
+	}
+	tracker, exists := pod.trackers[containerName]
+	if !exists {
+		tracker = logs.NewStepTracker()
+		pod.trackers[containerName] = tracker
+	}
+	return tracker
+}
+
+// summaries는 Pod의 Step 요약을 컨테이너 시작 순서, Step 시작 순서대로 반환합니다
+func (h *stepHistory) summaries(podName string) []*pb.StepSummary {
+	h.mu.Lock()
+	pod, exists := h.pods[podName]
+	var containers []string
+	trackers := make(map[string]*logs.StepTracker)
+	if exists {
+		containers = pod.containers
+		for name, tracker := range pod.trackers {
+			trackers[name] = tracker
+		}
+	}
+	h.mu.Unlock()
+
+	var summaries []*pb.StepSummary
+	for _, containerName := range containers {
+		tracker, exists := trackers[containerName]
+		if !exists {
+			continue
+		}
+		for _, step := range tracker.Steps() {
+			summaries = append(summaries, stepSummary(podName, containerName, step))
+		}
+	}
+	return summaries
+}
+
+// WorkerSteps returns the step summaries recognized in a worker's logs.
+//
+// WorkerSteps는 Worker 로그의 Step Marker로 인식한 Step 요약을 반환합니다.
+// 컨테이너 시작 순서, Step 시작 순서로 정렬되며, 로그 수집이 끝난 Worker도
+// 최근 maxStepHistoryPods개까지 조회할 수 있습니다. Job Worker는 설정 이름으로도 조회할 수 있습니다.
+func (m *Manager) WorkerSteps(podName string) []*pb.StepSummary {
+	return m.logCollector.steps.summaries(m.PodName(podName))
+}
+
+// finishSteps는 컨테이너 로그 수집이 끝날 때 열려 있는 Step을 컨테이너 종료 코드로 끝냅니다.
+// 컨테이너가 종료되지 않았거나 상태를 알 수 없으면 unknown으로 끝냅니다.
+func (lc *LogCollector) finishSteps(podName, containerName string, tracker *logs.StepTracker) {
+	ctx, cancel := context.WithTimeout(context.Background(), stepFinishTimeout)
+	defer cancel()
+
+	if pod, err := lc.workers.GetWorker(ctx, podName); err == nil {
+		if terminated := containerTerminated(pod, containerName); terminated != nil {
+			finishedAt := terminated.FinishedAt.Time
+			if finishedAt.IsZero() {
+				finishedAt = time.Now()
+			}
+			tracker.Finish(int(terminated.ExitCode), finishedAt)
+			return
+		}
+	}
+	tracker.Abandon(time.Now())
+}
+
+// containerTerminated는 Pod 상태에서 컨테이너의 종료 상태를 찾습니다 (종료되지 않았으면 nil)
+func containerTerminated(pod *v1.Pod, containerName string) *v1.ContainerStateTerminated {
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...),
+		pod.Status.ContainerStatuses...)
+
+	for _, status := range statuses {
+		if status.Name == containerName {
+			return status.State.Terminated
+		}
+	}
+	return nil
+}
+
+// stampStep은 로그 엔트리 metadata에 Step 이름을 기록하고, Marker 라인이면 이벤트와
+// (끝 Marker면) 실행 시간과 결과를 함께 기록합니다
+func stampStep(metadata map[string]string, mark logs.StepMark) {
+	metadata["step"] = mark.Step.Name
+	if mark.Event == "" {
+		return
+	}
+
+	metadata["step_event"] = string(mark.Event)
+	if mark.Event == logs.StepEnd {
+		metadata["step_status"] = string(mark.Step.Status)
+		metadata["step_exit_code"] = strconv.Itoa(mark.Step.ExitCode)
+		metadata["step_duration_ms"] = strconv.FormatInt(mark.Step.Duration().Milliseconds(), 10)
+	}
+}
+
+// stepSummary는 Step을 응답 메시지로 변환합니다
+func stepSummary(podName, containerName string, step logs.Step) *pb.StepSummary {
+	summary := &pb.StepSummary{
+		Name:       step.Name,
+		WorkerId:   podName,
+		Container:  containerName,
+		Status:     string(step.Status),
+		ExitCode:   int32(step.ExitCode),
+		StartedAt:  step.StartedAt.Format(time.RFC3339Nano),
+		DurationMs: step.Duration().Milliseconds(),
+	}
+	if !step.CompletedAt.IsZero() {
+		summary.CompletedAt = step.CompletedAt.Format(time.RFC3339Nano)
+	}
+	return summary
+}
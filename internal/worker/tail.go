@@ -0,0 +1,468 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Team-5-CodeCat/ottoscaler/internal/archive"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/k8s"
+	"github.com/Team-5-CodeCat/ottoscaler/internal/logs"
+	pb "github.com/Team-5-CodeCat/ottoscaler/pkg/proto/v1"
+)
+
+// maxTailBuffer는 follow 없이 조회할 때 시간순 정렬을 위해 모아두는 최대 엔트리 수입니다
+const maxTailBuffer = 50000
+
+// ErrNoWorkerLogs는 조회 범위에 실행 중인 Worker도, 보관된 로그도 없을 때 반환됩니다
+var ErrNoWorkerLogs = errors.New("no workers or archived logs found")
+
+// TailRequest selects the workers and lines to tail.
+//
+// TailRequest는 로그를 구독할 범위와 필터를 지정합니다.
+// WorkerID, TaskID, PipelineID 중 하나만 지정하며, StageID는 Pipeline 범위에서만 사용됩니다.
+type TailRequest struct {
+	WorkerID   string
+	TaskID     string
+	PipelineID string
+	StageID    string
+
+	Follow    bool      // 새 로그와 새로 생성되는 Worker를 계속 구독
+	Since     time.Time // 이 시간 이후의 로그만 (zero면 전체)
+	TailLines int64     // Worker(컨테이너)별 마지막 N줄만 (0이면 전체)
+	MinLevel  string    // 이 레벨 이상만 (DEBUG, INFO, WARN, ERROR, 비어있으면 전체)
+}
+
+// selector는 범위에 해당하는 Worker Pod 라벨 셀렉터를 반환합니다
+func (r TailRequest) selector() string {
+	switch {
+	case r.TaskID != "":
+		return "managed-by=ottoscaler,task-id=" + r.TaskID
+	case r.PipelineID != "" && r.StageID != "":
+		return fmt.Sprintf("managed-by=ottoscaler,pipeline-id=%s,stage-id=%s", r.PipelineID, r.StageID)
+	default:
+		return "managed-by=ottoscaler,pipeline-id=" + r.PipelineID
+	}
+}
+
+// TailLogs streams the logs of a worker, task or pipeline.
+//
+// TailLogs는 Worker, Task, Pipeline 범위의 로그를 하나의 채널로 스트리밍합니다.
+//
+// 동작 방식:
+//   - 이미 삭제된 Worker의 로그는 아카이브에서 채움 (backfill)
+//   - 실행 중인 Worker는 백엔드 로그 스트림(Kubernetes의 경우 StreamPodLogs)으로 읽음
+//   - Follow가 아니면 모든 엔트리를 시간순으로 정렬하여 보낸 뒤 채널을 닫음
+//   - Follow이면 새로 생성되는 Worker도 구독하며, ctx가 취소될 때까지 계속됨
+//     (Worker 범위는 해당 Worker가 종료되면 끝남)
+//
+// 모든 엔트리에는 worker_id와 metadata의 pipeline_id, stage_id, container가 태깅됩니다.
+func (m *Manager) TailLogs(ctx context.Context, req TailRequest) (<-chan *pb.WorkerLogEntry, <-chan error) {
+	if req.WorkerID != "" {
+		// Job Worker는 설정 이름으로도 조회 가능
+		req.WorkerID = m.PodName(req.WorkerID)
+	}
+
+	out := make(chan *pb.WorkerLogEntry, 100)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(out)
+		defer close(errChan)
+
+		tail := &logTail{
+			manager: m,
+			req:     req,
+			out:     out,
+			minRank: logs.LevelRank(logs.NormalizeLevel(req.MinLevel)),
+			started: make(map[string]bool),
+		}
+		if err := tail.run(ctx); err != nil && ctx.Err() == nil {
+			errChan <- err
+		}
+	}()
+
+	return out, errChan
+}
+
+// logTail은 TailLogs 호출 하나의 상태입니다
+type logTail struct {
+	manager *Manager
+	req     TailRequest
+	out     chan<- *pb.WorkerLogEntry
+	minRank int
+
+	mu      sync.Mutex
+	started map[string]bool      // 스트리밍을 시작한 Pod
+	buffer  []*pb.WorkerLogEntry // follow가 아닐 때 정렬을 위해 모은 엔트리
+	wg      sync.WaitGroup
+}
+
+// run은 backfill 후 실행 중인 Worker의 로그를 스트리밍합니다
+func (t *logTail) run(ctx context.Context) error {
+	pods, err := t.listPods(ctx)
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		live[pod.Name] = true
+	}
+
+	archived, err := t.archivedEntries(ctx, live)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 && len(archived) == 0 && !t.req.Follow {
+		return ErrNoWorkerLogs
+	}
+
+	if !t.req.Follow {
+		t.buffer = archived
+		for i := range pods {
+			t.startPod(ctx, &pods[i])
+		}
+		t.wg.Wait()
+		return t.flushBuffer(ctx)
+	}
+
+	sortEntries(archived)
+	for _, entry := range archived {
+		if !t.send(ctx, entry) {
+			return nil
+		}
+	}
+
+	for i := range pods {
+		t.startPod(ctx, &pods[i])
+	}
+
+	// Worker 범위는 해당 Worker의 스트림이 끝나면 종료
+	if t.req.WorkerID != "" {
+		t.wg.Wait()
+		return nil
+	}
+
+	// Task/Pipeline 범위는 새로 생성되는 Worker도 계속 구독
+	ticker := time.NewTicker(PodMonitoringInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			t.wg.Wait()
+			return nil
+		case <-ticker.C:
+			pods, err := t.listPods(ctx)
+			if err != nil {
+				continue
+			}
+			for i := range pods {
+				t.startPod(ctx, &pods[i])
+			}
+		}
+	}
+}
+
+// listPods는 범위에 해당하는 실행 중인 Worker 목록을 조회합니다
+func (t *logTail) listPods(ctx context.Context) ([]v1.Pod, error) {
+	if t.req.WorkerID != "" {
+		pod, err := t.manager.workers.GetWorker(ctx, t.req.WorkerID)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []v1.Pod{*pod}, nil
+	}
+
+	pods, err := t.manager.workers.ListWorkers(ctx, t.req.selector())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workers: %w", err)
+	}
+	return pods, nil
+}
+
+// archivedEntries는 더 이상 실행 중이 아닌 Worker의 보관된 로그를 읽습니다
+func (t *logTail) archivedEntries(ctx context.Context, live map[string]bool) ([]*pb.WorkerLogEntry, error) {
+	logArchive := t.manager.LogArchive()
+	if logArchive == nil {
+		return nil, nil
+	}
+
+	var workerIDs []string
+	var err error
+	switch {
+	case t.req.WorkerID != "":
+		workerIDs = []string{t.req.WorkerID}
+	case t.req.TaskID != "":
+		workerIDs, err = logArchive.WorkersForTask(ctx, t.req.TaskID)
+	default:
+		workerIDs, err = logArchive.WorkersForPipeline(ctx, t.req.PipelineID, t.req.StageID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up archived logs: %w", err)
+	}
+
+	var entries []*pb.WorkerLogEntry
+	for _, workerID := range workerIDs {
+		if live[workerID] {
+			continue
+		}
+
+		manifest, err := logArchive.Manifest(ctx, workerID)
+		if errors.Is(err, archive.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archived logs of %s: %w", workerID, err)
+		}
+
+		var workerEntries []*pb.WorkerLogEntry
+		err = logArchive.ReadEntries(ctx, workerID, func(_ int64, entry archive.Entry) bool {
+			if !t.req.Since.IsZero() && entry.Timestamp.Before(t.req.Since) {
+				return true
+			}
+			if logs.LevelRank(entry.Level) < t.minRank {
+				return true
+			}
+			workerEntries = append(workerEntries, archivedLogEntry(manifest, entry))
+			if t.req.TailLines > 0 && int64(len(workerEntries)) > t.req.TailLines {
+				workerEntries = workerEntries[1:]
+			}
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archived logs of %s: %w", workerID, err)
+		}
+		entries = append(entries, workerEntries...)
+	}
+
+	return entries, nil
+}
+
+// startPod는 Pod의 모든 컨테이너 로그 스트리밍을 시작합니다 (이미 시작했으면 무시)
+func (t *logTail) startPod(ctx context.Context, pod *v1.Pod) {
+	t.mu.Lock()
+	if t.started[pod.Name] {
+		t.mu.Unlock()
+		return
+	}
+	t.started[pod.Name] = true
+	t.mu.Unlock()
+
+	for _, containerName := range podContainers(pod) {
+		if !t.req.Follow && !containerStarted(pod, containerName) {
+			continue
+		}
+
+		t.wg.Add(1)
+		go func(containerName string) {
+			defer t.wg.Done()
+			t.streamContainer(ctx, pod, containerName)
+		}(containerName)
+	}
+}
+
+// streamContainer는 컨테이너 하나의 로그를 읽어 분석, 필터링 후 전달합니다
+func (t *logTail) streamContainer(ctx context.Context, pod *v1.Pod, containerName string) {
+	if t.req.Follow {
+		started, err := t.manager.logCollector.waitForContainerStart(ctx, pod.Name, containerName)
+		if err != nil {
+			return
+		}
+		pod = started
+	}
+
+	options := k8s.LogStreamOptions{
+		Follow:     t.req.Follow,
+		Timestamps: true,
+		Container:  containerName,
+	}
+	if t.req.TailLines > 0 {
+		tailLines := t.req.TailLines
+		options.TailLines = &tailLines
+	}
+	if !t.req.Since.IsZero() {
+		options.SinceTime = &metav1.Time{Time: t.req.Since}
+	}
+
+	taskID := pod.Labels["task-id"]
+	if taskID == "" {
+		taskID = "unknown"
+	}
+
+	lc := t.manager.logCollector
+	lc.logMutex.RLock()
+	parsers, content := lc.parsers, lc.content
+	lc.logMutex.RUnlock()
+
+	source := newLogSource(pod, t.manager.namespace, containerName, taskID)
+	source.setRedactor(lc.newRedactor(ctx, pod))
+	processor := logs.NewProcessor(parsers...).WithANSI(content.ANSI)
+	emit := func(records []logs.Record) bool {
+		for _, record := range records {
+			if logs.LevelRank(record.Level) < t.minRank {
+				continue
+			}
+			entry := source.convert(record)
+			metadata := make(map[string]string, len(entry.Metadata)+1)
+			for key, value := range entry.Metadata {
+				metadata[key] = value
+			}
+			metadata["origin"] = "live"
+			entry.Metadata = metadata
+			if !t.emit(ctx, entry) {
+				return false
+			}
+		}
+		return true
+	}
+
+	logChan, errChan := t.manager.workers.StreamWorkerLogs(ctx, pod.Name, options)
+
+	var flushTimer <-chan time.Time
+	for {
+		select {
+		case entry, ok := <-logChan:
+			if !ok {
+				emit(processor.Flush())
+				return
+			}
+			if !emit(processor.Process(entry)) {
+				return
+			}
+			if processor.Pending() && flushTimer == nil {
+				flushTimer = time.After(logGroupFlushDelay)
+			}
+
+		case <-flushTimer:
+			flushTimer = nil
+			if !emit(processor.Flush()) {
+				return
+			}
+
+		case _, ok := <-errChan:
+			if !ok {
+				errChan = nil
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// emit은 follow 모드에서는 바로 전송하고, 아니면 정렬을 위해 버퍼에 모읍니다
+func (t *logTail) emit(ctx context.Context, entry *pb.WorkerLogEntry) bool {
+	if t.req.Follow {
+		return t.send(ctx, entry)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.buffer = append(t.buffer, entry)
+	if len(t.buffer) >= maxTailBuffer {
+		// 버퍼가 가득 차면 지금까지 모은 엔트리를 정렬하여 먼저 보냄
+		if err := t.flushBufferLocked(ctx); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// flushBuffer는 모은 엔트리를 시간순으로 정렬하여 보냅니다
+func (t *logTail) flushBuffer(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.flushBufferLocked(ctx)
+}
+
+func (t *logTail) flushBufferLocked(ctx context.Context) error {
+	sortEntries(t.buffer)
+	for _, entry := range t.buffer {
+		if !t.send(ctx, entry) {
+			return ctx.Err()
+		}
+	}
+	t.buffer = nil
+	return nil
+}
+
+// send는 엔트리를 출력 채널로 보냅니다 (ctx가 취소되면 false)
+func (t *logTail) send(ctx context.Context, entry *pb.WorkerLogEntry) bool {
+	select {
+	case t.out <- entry:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// archivedLogEntry는 보관된 엔트리를 WorkerLogEntry로 변환합니다
+func archivedLogEntry(manifest *archive.Manifest, entry archive.Entry) *pb.WorkerLogEntry {
+	metadata := make(map[string]string, len(entry.Metadata)+3)
+	for key, value := range entry.Metadata {
+		metadata[key] = value
+	}
+	if manifest.PipelineID != "" {
+		metadata["pipeline_id"] = manifest.PipelineID
+	}
+	if manifest.StageID != "" {
+		metadata["stage_id"] = manifest.StageID
+	}
+	metadata["origin"] = "archive"
+
+	taskID := manifest.TaskID
+	if taskID == "" {
+		taskID = "unknown"
+	}
+
+	return &pb.WorkerLogEntry{
+		WorkerId:  manifest.WorkerID,
+		TaskId:    taskID,
+		Timestamp: entry.Timestamp.Format(time.RFC3339Nano),
+		Level:     entry.Level,
+		Source:    entry.Source,
+		Message:   entry.Message,
+		PodMetadata: &pb.WorkerMetadata{
+			PodName:   manifest.WorkerID,
+			Namespace: manifest.Namespace,
+			Labels:    manifest.Labels,
+		},
+		Metadata: metadata,
+	}
+}
+
+// sortEntries는 엔트리를 타임스탬프 순으로 정렬합니다 (같은 시간이면 기존 순서 유지).
+// RFC3339Nano는 소수점 아래 0을 생략하므로 문자열이 아닌 파싱한 시간으로 비교합니다.
+func sortEntries(entries []*pb.WorkerLogEntry) {
+	type timedEntry struct {
+		entry *pb.WorkerLogEntry
+		at    time.Time
+	}
+
+	timed := make([]timedEntry, len(entries))
+	for i, entry := range entries {
+		// 파싱할 수 없는 타임스탬프는 가장 앞으로
+		at, _ := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		timed[i] = timedEntry{entry: entry, at: at}
+	}
+
+	sort.SliceStable(timed, func(i, j int) bool {
+		return timed[i].at.Before(timed[j].at)
+	})
+	for i := range timed {
+		entries[i] = timed[i].entry
+	}
+}
@@ -0,0 +1,273 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// PodTemplateRefreshInterval은 기본 PodTemplate을 다시 읽어오는 간격입니다
+const PodTemplateRefreshInterval = 1 * time.Minute
+
+// PodTemplateSource identifies the base PodTemplate for Worker Pods.
+//
+// PodTemplateSource는 Worker Pod의 기반이 되는 PodTemplate 위치를 나타냅니다.
+// File(YAML 매니페스트 경로)과 Name(클러스터 내 PodTemplate 오브젝트) 중 하나만 사용합니다.
+type PodTemplateSource struct {
+	File string
+	Name string
+}
+
+// IsEmpty는 템플릿이 지정되지 않았는지 반환합니다
+func (s PodTemplateSource) IsEmpty() bool {
+	return s.File == "" && s.Name == ""
+}
+
+// String은 로그 출력용 템플릿 위치를 반환합니다
+func (s PodTemplateSource) String() string {
+	if s.File != "" {
+		return "file:" + s.File
+	}
+	return "podtemplate/" + s.Name
+}
+
+// podTemplateCache는 마지막으로 읽어온 기본 PodTemplate을 보관합니다
+type podTemplateCache struct {
+	source   PodTemplateSource
+	template *v1.PodTemplateSpec
+	loadedAt time.Time
+	mu       sync.Mutex
+}
+
+// SetPodTemplateSource configures the base PodTemplate merged into every Worker Pod.
+//
+// SetPodTemplateSource는 모든 Worker Pod에 적용할 기본 PodTemplate을 설정합니다.
+// 플랫폼 팀은 코드 변경 없이 사이드카, 볼륨, DNS 설정, hostAliases 등을 추가할 수 있습니다.
+func (m *Manager) SetPodTemplateSource(source PodTemplateSource) {
+	m.podTemplate.mu.Lock()
+	defer m.podTemplate.mu.Unlock()
+
+	m.podTemplate.source = source
+	m.podTemplate.template = nil
+	m.podTemplate.loadedAt = time.Time{}
+
+	if !source.IsEmpty() {
+		log.Printf("📐 Worker pod template configured: %s", source)
+	}
+}
+
+// applyPodTemplate은 생성된 Pod를 기본 PodTemplate 위에 strategic merge 합니다
+func (m *Manager) applyPodTemplate(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
+	base, err := m.loadPodTemplate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return pod, nil
+	}
+
+	return mergePodTemplate(base, pod)
+}
+
+// loadPodTemplate은 기본 PodTemplate을 읽어옵니다 (PodTemplateRefreshInterval 동안 캐시)
+func (m *Manager) loadPodTemplate(ctx context.Context) (*v1.PodTemplateSpec, error) {
+	cache := &m.podTemplate
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.source.IsEmpty() {
+		return nil, nil
+	}
+
+	if cache.template != nil && time.Since(cache.loadedAt) < PodTemplateRefreshInterval {
+		return cache.template.DeepCopy(), nil
+	}
+
+	var (
+		template *v1.PodTemplateSpec
+		err      error
+	)
+	if cache.source.File != "" {
+		template, err = loadPodTemplateFile(cache.source.File)
+	} else {
+		template, err = m.loadPodTemplateObject(ctx, cache.source.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pod template %s: %w", cache.source, err)
+	}
+
+	cache.template = template
+	cache.loadedAt = time.Now()
+	return template.DeepCopy(), nil
+}
+
+// loadPodTemplateObject는 클러스터 내 PodTemplate 오브젝트를 조회합니다
+func (m *Manager) loadPodTemplateObject(ctx context.Context, name string) (*v1.PodTemplateSpec, error) {
+	if m.k8sClient == nil {
+		return nil, fmt.Errorf("pod template objects require a Kubernetes client")
+	}
+
+	podTemplate, err := m.k8sClient.GetPodTemplate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &podTemplate.Template, nil
+}
+
+// loadPodTemplateFile은 YAML 매니페스트에서 PodTemplate을 읽어옵니다.
+//
+// 지원하는 형식:
+//   - kind: PodTemplate (template 필드 사용)
+//   - kind: Pod (metadata와 spec 사용)
+func loadPodTemplateFile(path string) (*v1.PodTemplateSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pod template file: %w", err)
+	}
+
+	var manifest struct {
+		Kind string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse pod template file: %w", err)
+	}
+
+	switch manifest.Kind {
+	case "PodTemplate":
+		var podTemplate v1.PodTemplate
+		if err := yaml.UnmarshalStrict(data, &podTemplate); err != nil {
+			return nil, fmt.Errorf("failed to parse PodTemplate: %w", err)
+		}
+		return &podTemplate.Template, nil
+
+	case "Pod":
+		var pod v1.Pod
+		if err := yaml.UnmarshalStrict(data, &pod); err != nil {
+			return nil, fmt.Errorf("failed to parse Pod: %w", err)
+		}
+		return &v1.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported kind %q (expected PodTemplate or Pod)", manifest.Kind)
+	}
+}
+
+// mergePodTemplate은 생성된 Pod를 기본 템플릿에 strategic merge 합니다.
+//
+// 컨테이너는 이름으로 병합되므로 템플릿에 "worker" 컨테이너가 있으면
+// 생성된 Worker 컨테이너 설정이 그 위에 덮어써지고, 나머지 컨테이너(사이드카)는 유지됩니다.
+// 라벨과 어노테이션은 키 단위로 병합되며 생성된 값이 우선합니다.
+func mergePodTemplate(base *v1.PodTemplateSpec, generated *v1.Pod) (*v1.Pod, error) {
+	basePod := &v1.Pod{
+		ObjectMeta: base.ObjectMeta,
+		Spec:       base.Spec,
+	}
+	// 이름과 네임스페이스는 항상 생성된 값을 사용
+	basePod.Name = ""
+	basePod.GenerateName = ""
+	basePod.Namespace = ""
+
+	baseJSON, err := json.Marshal(basePod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pod template: %w", err)
+	}
+
+	generatedJSON, err := json.Marshal(generated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode generated pod: %w", err)
+	}
+
+	mergedJSON, err := strategicpatch.StrategicMergePatch(baseJSON, generatedJSON, v1.Pod{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge pod template: %w", err)
+	}
+
+	var merged v1.Pod
+	if err := json.Unmarshal(mergedJSON, &merged); err != nil {
+		return nil, fmt.Errorf("failed to decode merged pod: %w", err)
+	}
+
+	return &merged, nil
+}
+
+// validatePodSpec은 생성 전에 Pod 스펙의 기본적인 유효성을 검사합니다.
+//
+// API 서버 검증을 대체하지는 않지만, 템플릿 병합 실수로 인한
+// 흔한 오류를 Pod 생성 전에 명확한 메시지로 알려줍니다.
+func validatePodSpec(pod *v1.Pod) error {
+	var errs []string
+
+	for _, msg := range validation.IsDNS1123Subdomain(pod.Name) {
+		errs = append(errs, fmt.Sprintf("metadata.name %q: %s", pod.Name, msg))
+	}
+
+	for key, value := range pod.Labels {
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, fmt.Sprintf("label key %q: %s", key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(value) {
+			errs = append(errs, fmt.Sprintf("label %q value %q: %s", key, value, msg))
+		}
+	}
+
+	switch pod.Spec.RestartPolicy {
+	case v1.RestartPolicyNever, v1.RestartPolicyOnFailure:
+	default:
+		errs = append(errs, fmt.Sprintf("spec.restartPolicy %q is not allowed for worker pods", pod.Spec.RestartPolicy))
+	}
+
+	if len(pod.Spec.Containers) == 0 {
+		errs = append(errs, "spec.containers: at least one container is required")
+	}
+
+	volumes := make(map[string]bool, len(pod.Spec.Volumes))
+	for _, volume := range pod.Spec.Volumes {
+		if volumes[volume.Name] {
+			errs = append(errs, fmt.Sprintf("spec.volumes: duplicate volume %q", volume.Name))
+		}
+		volumes[volume.Name] = true
+	}
+
+	containerNames := make(map[string]bool)
+	checkContainer := func(field string, container v1.Container) {
+		if container.Name == "" {
+			errs = append(errs, fmt.Sprintf("%s: container name is required", field))
+		} else if containerNames[container.Name] {
+			errs = append(errs, fmt.Sprintf("%s: duplicate container name %q", field, container.Name))
+		}
+		containerNames[container.Name] = true
+
+		if container.Image == "" {
+			errs = append(errs, fmt.Sprintf("%s[%s]: image is required", field, container.Name))
+		}
+
+		for _, mount := range container.VolumeMounts {
+			if !volumes[mount.Name] {
+				errs = append(errs, fmt.Sprintf("%s[%s]: volume mount %q refers to an undefined volume",
+					field, container.Name, mount.Name))
+			}
+		}
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		checkContainer("spec.initContainers", container)
+	}
+	for _, container := range pod.Spec.Containers {
+		checkContainer("spec.containers", container)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid worker pod %s: %s", pod.Name, strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -21,6 +21,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// LogFormat - 로그 응답 형식
+type LogFormat int32
+
+const (
+	LogFormat_LOG_FORMAT_TEXT LogFormat = 0 // "<timestamp> [LEVEL] message" 형식의 텍스트
+	LogFormat_LOG_FORMAT_JSON LogFormat = 1 // 엔트리당 한 줄의 JSON Lines
+)
+
+// Enum value maps for LogFormat.
+var (
+	LogFormat_name = map[int32]string{
+		0: "LOG_FORMAT_TEXT",
+		1: "LOG_FORMAT_JSON",
+	}
+	LogFormat_value = map[string]int32{
+		"LOG_FORMAT_TEXT": 0,
+		"LOG_FORMAT_JSON": 1,
+	}
+)
+
+func (x LogFormat) Enum() *LogFormat {
+	p := new(LogFormat)
+	*p = x
+	return p
+}
+
+func (x LogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_log_streaming_proto_enumTypes[0].Descriptor()
+}
+
+func (LogFormat) Type() protoreflect.EnumType {
+	return &file_log_streaming_proto_enumTypes[0]
+}
+
+func (x LogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogFormat.Descriptor instead.
+func (LogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{0}
+}
+
 // StageStatus - Pipeline Stage 상태
 type StageStatus int32
 
@@ -67,11 +114,11 @@ func (x StageStatus) String() string {
 }
 
 func (StageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[0].Descriptor()
+	return file_log_streaming_proto_enumTypes[1].Descriptor()
 }
 
 func (StageStatus) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[0]
+	return &file_log_streaming_proto_enumTypes[1]
 }
 
 func (x StageStatus) Number() protoreflect.EnumNumber {
@@ -80,7 +127,7 @@ func (x StageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StageStatus.Descriptor instead.
 func (StageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{0}
+	return file_log_streaming_proto_rawDescGZIP(), []int{1}
 }
 
 // Status - 로그 처리 결과 상태
@@ -121,11 +168,11 @@ func (x LogResponse_Status) String() string {
 }
 
 func (LogResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[1].Descriptor()
+	return file_log_streaming_proto_enumTypes[2].Descriptor()
 }
 
 func (LogResponse_Status) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[1]
+	return &file_log_streaming_proto_enumTypes[2]
 }
 
 func (x LogResponse_Status) Number() protoreflect.EnumNumber {
@@ -173,11 +220,11 @@ func (x RegistrationResponse_Status) String() string {
 }
 
 func (RegistrationResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[2].Descriptor()
+	return file_log_streaming_proto_enumTypes[3].Descriptor()
 }
 
 func (RegistrationResponse_Status) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[2]
+	return &file_log_streaming_proto_enumTypes[3]
 }
 
 func (x RegistrationResponse_Status) Number() protoreflect.EnumNumber {
@@ -225,11 +272,11 @@ func (x ScaleResponse_Status) String() string {
 }
 
 func (ScaleResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[3].Descriptor()
+	return file_log_streaming_proto_enumTypes[4].Descriptor()
 }
 
 func (ScaleResponse_Status) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[3]
+	return &file_log_streaming_proto_enumTypes[4]
 }
 
 func (x ScaleResponse_Status) Number() protoreflect.EnumNumber {
@@ -274,11 +321,11 @@ func (x LogForwardResponse_Status) String() string {
 }
 
 func (LogForwardResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[4].Descriptor()
+	return file_log_streaming_proto_enumTypes[5].Descriptor()
 }
 
 func (LogForwardResponse_Status) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[4]
+	return &file_log_streaming_proto_enumTypes[5]
 }
 
 func (x LogForwardResponse_Status) Number() protoreflect.EnumNumber {
@@ -287,7 +334,7 @@ func (x LogForwardResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogForwardResponse_Status.Descriptor instead.
 func (LogForwardResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{12, 0}
+	return file_log_streaming_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type WorkerStatusNotification_StatusType int32
@@ -329,11 +376,11 @@ func (x WorkerStatusNotification_StatusType) String() string {
 }
 
 func (WorkerStatusNotification_StatusType) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[5].Descriptor()
+	return file_log_streaming_proto_enumTypes[6].Descriptor()
 }
 
 func (WorkerStatusNotification_StatusType) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[5]
+	return &file_log_streaming_proto_enumTypes[6]
 }
 
 func (x WorkerStatusNotification_StatusType) Number() protoreflect.EnumNumber {
@@ -342,7 +389,7 @@ func (x WorkerStatusNotification_StatusType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkerStatusNotification_StatusType.Descriptor instead.
 func (WorkerStatusNotification_StatusType) EnumDescriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{13, 0}
+	return file_log_streaming_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type WorkerStatusAck_Status int32
@@ -378,11 +425,11 @@ func (x WorkerStatusAck_Status) String() string {
 }
 
 func (WorkerStatusAck_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_log_streaming_proto_enumTypes[6].Descriptor()
+	return file_log_streaming_proto_enumTypes[7].Descriptor()
 }
 
 func (WorkerStatusAck_Status) Type() protoreflect.EnumType {
-	return &file_log_streaming_proto_enumTypes[6]
+	return &file_log_streaming_proto_enumTypes[7]
 }
 
 func (x WorkerStatusAck_Status) Number() protoreflect.EnumNumber {
@@ -391,7 +438,7 @@ func (x WorkerStatusAck_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkerStatusAck_Status.Descriptor instead.
 func (WorkerStatusAck_Status) EnumDescriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{14, 0}
+	return file_log_streaming_proto_rawDescGZIP(), []int{25, 0}
 }
 
 // LogEntry - Worker Pod에서 생성되는 단일 로그 엔트리
@@ -434,7 +481,10 @@ type LogEntry struct {
 	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	// 추가 메타데이터 (선택적)
 	// 작업 단계, 파일명, 함수명 등 부가 정보
-	Metadata      map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// RegisterWorker가 반환한 세션 ID
+	// 부트스트랩 토큰 검증을 켜면 스트림의 첫 로그에 필수이며, 이후 로그는 같은 Worker여야 함
+	SessionId     string `protobuf:"bytes,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -518,6 +568,13 @@ func (x *LogEntry) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *LogEntry) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 // LogResponse - NestJS 서버에서 Worker Pod로의 응답 메시지
 //
 // 🔄 응답 처리 로직:
@@ -604,6 +661,9 @@ type WorkerRegistration struct {
 	Metadata *WorkerMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// NestJS server endpoint (for health checks)
 	ServerEndpoint string `protobuf:"bytes,4,opt,name=server_endpoint,json=serverEndpoint,proto3" json:"server_endpoint,omitempty"`
+	// Ottoscaler가 Pod 생성 시 주입한 부트스트랩 토큰 (OTTOSCALER_BOOTSTRAP_TOKEN 환경 변수)
+	// Pod 이름(또는 Job)과 task_id에 묶인 짧은 수명의 서명 토큰
+	BootstrapToken string `protobuf:"bytes,5,opt,name=bootstrap_token,json=bootstrapToken,proto3" json:"bootstrap_token,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -666,6 +726,13 @@ func (x *WorkerRegistration) GetServerEndpoint() string {
 	return ""
 }
 
+func (x *WorkerRegistration) GetBootstrapToken() string {
+	if x != nil {
+		return x.BootstrapToken
+	}
+	return ""
+}
+
 // WorkerMetadata contains Kubernetes pod information
 type WorkerMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1266,7 +1333,11 @@ type WorkerPodStatus struct {
 	// 추가 라벨
 	Labels map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// 에러 메시지 (실패한 경우)
-	ErrorMessage  string `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 의존성 캐시 재사용 여부 (기존에 채워진 캐시 볼륨을 마운트한 경우 true)
+	CacheHit bool `protobuf:"varint,11,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	// Worker 로그의 Step Marker로 구분된 Step 요약 (시작 순서)
+	Steps         []*StepSummary `protobuf:"bytes,12,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1371,43 +1442,58 @@ func (x *WorkerPodStatus) GetErrorMessage() string {
 	return ""
 }
 
-// WorkerLogEntry - Ottoscaler에서 Otto-handler로 전달하는 Worker 로그 엔트리
-type WorkerLogEntry struct {
+func (x *WorkerPodStatus) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *WorkerPodStatus) GetSteps() []*StepSummary {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// StepSummary - Worker 로그에서 인식한 Step 하나의 요약
+// (::group::이름 / ::endgroup::, ##[step] 이름 Marker로 구분)
+type StepSummary struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Worker Pod의 고유 식별자
-	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	// 작업 식별자 (CI/CD 작업 ID)
-	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// 로그 생성 시간 (RFC3339 형식)
-	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// 로그 레벨 (INFO, ERROR, DEBUG, WARN)
-	Level string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
-	// 로그 소스 (stdout, stderr)
-	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
-	// 실제 로그 메시지 내용
-	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	// Pod 관련 메타데이터
-	PodMetadata *WorkerMetadata `protobuf:"bytes,7,opt,name=pod_metadata,json=podMetadata,proto3" json:"pod_metadata,omitempty"`
-	// 추가 메타데이터
-	Metadata      map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Step 이름
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Step이 실행된 Worker Pod 이름
+	WorkerId string `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// Step을 출력한 컨테이너 이름
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	// Step 상태 ("running", "succeeded", "failed", "unknown")
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 종료 코드 (status가 succeeded 또는 failed일 때만 의미 있음)
+	ExitCode int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Step 시작 시간 (시작 Marker 로그의 타임스탬프)
+	StartedAt string `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Step 완료 시간 (완료된 경우)
+	CompletedAt string `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// 실행 시간 (밀리초, 실행 중이면 지금까지의 시간)
+	DurationMs    int64 `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerLogEntry) Reset() {
-	*x = WorkerLogEntry{}
+func (x *StepSummary) Reset() {
+	*x = StepSummary{}
 	mi := &file_log_streaming_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerLogEntry) String() string {
+func (x *StepSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerLogEntry) ProtoMessage() {}
+func (*StepSummary) ProtoMessage() {}
 
-func (x *WorkerLogEntry) ProtoReflect() protoreflect.Message {
+func (x *StepSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_log_streaming_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,96 +1505,104 @@ func (x *WorkerLogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerLogEntry.ProtoReflect.Descriptor instead.
-func (*WorkerLogEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use StepSummary.ProtoReflect.Descriptor instead.
+func (*StepSummary) Descriptor() ([]byte, []int) {
 	return file_log_streaming_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *WorkerLogEntry) GetWorkerId() string {
+func (x *StepSummary) GetName() string {
 	if x != nil {
-		return x.WorkerId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WorkerLogEntry) GetTaskId() string {
+func (x *StepSummary) GetWorkerId() string {
 	if x != nil {
-		return x.TaskId
+		return x.WorkerId
 	}
 	return ""
 }
 
-func (x *WorkerLogEntry) GetTimestamp() string {
+func (x *StepSummary) GetContainer() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Container
 	}
 	return ""
 }
 
-func (x *WorkerLogEntry) GetLevel() string {
+func (x *StepSummary) GetStatus() string {
 	if x != nil {
-		return x.Level
+		return x.Status
 	}
 	return ""
 }
 
-func (x *WorkerLogEntry) GetSource() string {
+func (x *StepSummary) GetExitCode() int32 {
 	if x != nil {
-		return x.Source
+		return x.ExitCode
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkerLogEntry) GetMessage() string {
+func (x *StepSummary) GetStartedAt() string {
 	if x != nil {
-		return x.Message
+		return x.StartedAt
 	}
 	return ""
 }
 
-func (x *WorkerLogEntry) GetPodMetadata() *WorkerMetadata {
+func (x *StepSummary) GetCompletedAt() string {
 	if x != nil {
-		return x.PodMetadata
+		return x.CompletedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkerLogEntry) GetMetadata() map[string]string {
+func (x *StepSummary) GetDurationMs() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.DurationMs
 	}
-	return nil
+	return 0
 }
 
-// LogForwardResponse - Otto-handler에서 Ottoscaler로의 로그 처리 응답
-type LogForwardResponse struct {
+// GetWorkerLogsRequest - 보관된 Worker 로그 조회 요청
+type GetWorkerLogsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 로그 처리 결과 상태
-	Status LogForwardResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ottoscaler.v1.LogForwardResponse_Status" json:"status,omitempty"`
-	// 응답 메시지 (에러 정보 또는 성공 메시지)
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// 순서 보장을 위한 시퀀스 번호
-	Sequence int64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// 백프레셔 제어: 다음 로그까지 대기 시간 (ms)
-	ThrottleMs    int32 `protobuf:"varint,4,opt,name=throttle_ms,json=throttleMs,proto3" json:"throttle_ms,omitempty"`
+	// 조회 대상 (하나만 지정)
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*GetWorkerLogsRequest_WorkerId
+	//	*GetWorkerLogsRequest_TaskId
+	//	*GetWorkerLogsRequest_PipelineId
+	Target isGetWorkerLogsRequest_Target `protobuf_oneof:"target"`
+	// Pipeline 조회 시 Stage 필터 (선택적)
+	StageId string `protobuf:"bytes,4,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// 조회 범위 (Worker별로 적용)
+	Range *LogRange `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"`
+	// 응답 형식
+	Format LogFormat `protobuf:"varint,6,opt,name=format,proto3,enum=ottoscaler.v1.LogFormat" json:"format,omitempty"`
+	// 이전 응답의 next_page_token (응답 크기 한도로 나뉜 결과를 이어서 조회)
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogForwardResponse) Reset() {
-	*x = LogForwardResponse{}
+func (x *GetWorkerLogsRequest) Reset() {
+	*x = GetWorkerLogsRequest{}
 	mi := &file_log_streaming_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogForwardResponse) String() string {
+func (x *GetWorkerLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogForwardResponse) ProtoMessage() {}
+func (*GetWorkerLogsRequest) ProtoMessage() {}
 
-func (x *LogForwardResponse) ProtoReflect() protoreflect.Message {
+func (x *GetWorkerLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_log_streaming_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1520,72 +1614,127 @@ func (x *LogForwardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogForwardResponse.ProtoReflect.Descriptor instead.
-func (*LogForwardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerLogsRequest) Descriptor() ([]byte, []int) {
 	return file_log_streaming_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LogForwardResponse) GetStatus() LogForwardResponse_Status {
+func (x *GetWorkerLogsRequest) GetTarget() isGetWorkerLogsRequest_Target {
 	if x != nil {
-		return x.Status
+		return x.Target
 	}
-	return LogForwardResponse_ACK
+	return nil
 }
 
-func (x *LogForwardResponse) GetMessage() string {
+func (x *GetWorkerLogsRequest) GetWorkerId() string {
 	if x != nil {
-		return x.Message
+		if x, ok := x.Target.(*GetWorkerLogsRequest_WorkerId); ok {
+			return x.WorkerId
+		}
 	}
 	return ""
 }
 
-func (x *LogForwardResponse) GetSequence() int64 {
+func (x *GetWorkerLogsRequest) GetTaskId() string {
 	if x != nil {
-		return x.Sequence
+		if x, ok := x.Target.(*GetWorkerLogsRequest_TaskId); ok {
+			return x.TaskId
+		}
 	}
-	return 0
+	return ""
 }
 
-func (x *LogForwardResponse) GetThrottleMs() int32 {
+func (x *GetWorkerLogsRequest) GetPipelineId() string {
 	if x != nil {
-		return x.ThrottleMs
+		if x, ok := x.Target.(*GetWorkerLogsRequest_PipelineId); ok {
+			return x.PipelineId
+		}
 	}
-	return 0
+	return ""
 }
 
-// WorkerStatusNotification - Worker Pod 상태 변경 알림
-type WorkerStatusNotification struct {
+func (x *GetWorkerLogsRequest) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *GetWorkerLogsRequest) GetRange() *LogRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *GetWorkerLogsRequest) GetFormat() LogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LogFormat_LOG_FORMAT_TEXT
+}
+
+func (x *GetWorkerLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type isGetWorkerLogsRequest_Target interface {
+	isGetWorkerLogsRequest_Target()
+}
+
+type GetWorkerLogsRequest_WorkerId struct {
+	// 특정 Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof"`
+}
+
+type GetWorkerLogsRequest_TaskId struct {
+	// 작업 ID (작업의 모든 Worker)
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3,oneof"`
+}
+
+type GetWorkerLogsRequest_PipelineId struct {
+	// Pipeline ID (stage_id로 Stage 한정 가능)
+	PipelineId string `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3,oneof"`
+}
+
+func (*GetWorkerLogsRequest_WorkerId) isGetWorkerLogsRequest_Target() {}
+
+func (*GetWorkerLogsRequest_TaskId) isGetWorkerLogsRequest_Target() {}
+
+func (*GetWorkerLogsRequest_PipelineId) isGetWorkerLogsRequest_Target() {}
+
+// LogRange - 로그 조회 범위
+type LogRange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Worker Pod 이름
-	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	// 작업 ID
-	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// 상태 타입
-	Status WorkerStatusNotification_StatusType `protobuf:"varint,3,opt,name=status,proto3,enum=ottoscaler.v1.WorkerStatusNotification_StatusType" json:"status,omitempty"`
-	// 상태 변경 시간
-	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// 상태 변경 이유/메시지
-	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	// 추가 메타데이터
-	Metadata      map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 시작 라인 (0부터 시작)
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 최대 라인 수 (0이면 서버 기본값)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 이 시간 이후의 로그만 (RFC3339 형식, 선택적)
+	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// 이 시간 이전의 로그만 (RFC3339 형식, 선택적)
+	Until         string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerStatusNotification) Reset() {
-	*x = WorkerStatusNotification{}
+func (x *LogRange) Reset() {
+	*x = LogRange{}
 	mi := &file_log_streaming_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerStatusNotification) String() string {
+func (x *LogRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerStatusNotification) ProtoMessage() {}
+func (*LogRange) ProtoMessage() {}
 
-func (x *WorkerStatusNotification) ProtoReflect() protoreflect.Message {
+func (x *LogRange) ProtoReflect() protoreflect.Message {
 	mi := &file_log_streaming_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1597,76 +1746,66 @@ func (x *WorkerStatusNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerStatusNotification.ProtoReflect.Descriptor instead.
-func (*WorkerStatusNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogRange.ProtoReflect.Descriptor instead.
+func (*LogRange) Descriptor() ([]byte, []int) {
 	return file_log_streaming_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WorkerStatusNotification) GetWorkerId() string {
-	if x != nil {
-		return x.WorkerId
-	}
-	return ""
-}
-
-func (x *WorkerStatusNotification) GetTaskId() string {
+func (x *LogRange) GetOffset() int64 {
 	if x != nil {
-		return x.TaskId
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkerStatusNotification) GetStatus() WorkerStatusNotification_StatusType {
+func (x *LogRange) GetLimit() int32 {
 	if x != nil {
-		return x.Status
+		return x.Limit
 	}
-	return WorkerStatusNotification_CREATED
+	return 0
 }
 
-func (x *WorkerStatusNotification) GetTimestamp() string {
+func (x *LogRange) GetSince() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Since
 	}
 	return ""
 }
 
-func (x *WorkerStatusNotification) GetReason() string {
+func (x *LogRange) GetUntil() string {
 	if x != nil {
-		return x.Reason
+		return x.Until
 	}
 	return ""
 }
 
-func (x *WorkerStatusNotification) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// WorkerStatusAck - Worker 상태 알림에 대한 응답
-type WorkerStatusAck struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        WorkerStatusAck_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ottoscaler.v1.WorkerStatusAck_Status" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// GetWorkerLogsResponse - 보관된 Worker 로그 조회 응답
+type GetWorkerLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker별 로그 (Worker 이름 순)
+	Workers []*ArchivedWorkerLogs `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	// 응답 전체의 라인 수 또는 크기 한도에 도달해 더 읽을 로그가 있는지 여부
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// 다음 페이지 토큰 (비어있으면 마지막 페이지)
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerStatusAck) Reset() {
-	*x = WorkerStatusAck{}
+func (x *GetWorkerLogsResponse) Reset() {
+	*x = GetWorkerLogsResponse{}
 	mi := &file_log_streaming_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerStatusAck) String() string {
+func (x *GetWorkerLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerStatusAck) ProtoMessage() {}
+func (*GetWorkerLogsResponse) ProtoMessage() {}
 
-func (x *WorkerStatusAck) ProtoReflect() protoreflect.Message {
+func (x *GetWorkerLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_log_streaming_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1678,14 +1817,1127 @@ func (x *WorkerStatusAck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerStatusAck.ProtoReflect.Descriptor instead.
-func (*WorkerStatusAck) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkerLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerLogsResponse) Descriptor() ([]byte, []int) {
 	return file_log_streaming_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WorkerStatusAck) GetStatus() WorkerStatusAck_Status {
+func (x *GetWorkerLogsResponse) GetWorkers() []*ArchivedWorkerLogs {
 	if x != nil {
-		return x.Status
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *GetWorkerLogsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetWorkerLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ArchivedWorkerLogs - Worker 하나의 보관된 로그
+type ArchivedWorkerLogs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// 작업 ID
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Pipeline ID (Pipeline Worker인 경우)
+	PipelineId string `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	// Stage ID (Pipeline Worker인 경우)
+	StageId string `protobuf:"bytes,4,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// 보관된 전체 라인 수
+	TotalLines int64 `protobuf:"varint,5,opt,name=total_lines,json=totalLines,proto3" json:"total_lines,omitempty"`
+	// 응답에 포함된 첫 라인 번호
+	Offset int64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 응답에 포함된 라인 수
+	LineCount int32 `protobuf:"varint,7,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	// 범위 내에 더 읽을 라인이 있는지 여부 (offset + line_count부터 이어서 조회)
+	HasMore bool `protobuf:"varint,8,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// 로그 내용 (요청한 형식)
+	Content string `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
+	// 보관 시간 (RFC3339 형식)
+	ArchivedAt    string `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedWorkerLogs) Reset() {
+	*x = ArchivedWorkerLogs{}
+	mi := &file_log_streaming_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedWorkerLogs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedWorkerLogs) ProtoMessage() {}
+
+func (x *ArchivedWorkerLogs) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedWorkerLogs.ProtoReflect.Descriptor instead.
+func (*ArchivedWorkerLogs) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArchivedWorkerLogs) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *ArchivedWorkerLogs) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ArchivedWorkerLogs) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *ArchivedWorkerLogs) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *ArchivedWorkerLogs) GetTotalLines() int64 {
+	if x != nil {
+		return x.TotalLines
+	}
+	return 0
+}
+
+func (x *ArchivedWorkerLogs) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ArchivedWorkerLogs) GetLineCount() int32 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *ArchivedWorkerLogs) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ArchivedWorkerLogs) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ArchivedWorkerLogs) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// TailLogsRequest - 로그 실시간 구독 요청
+type TailLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 구독 대상 (하나만 지정)
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*TailLogsRequest_WorkerId
+	//	*TailLogsRequest_TaskId
+	//	*TailLogsRequest_PipelineId
+	Target isTailLogsRequest_Target `protobuf_oneof:"target"`
+	// Pipeline 구독 시 Stage 필터 (선택적)
+	StageId string `protobuf:"bytes,4,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// 새 로그를 계속 구독할지 여부
+	Follow bool `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	// 이 시간 이후의 로그만 (RFC3339 형식, 선택적)
+	Since string `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	// 컨테이너별 마지막 N줄만 (0이면 전체)
+	TailLines int64 `protobuf:"varint,7,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// 최소 로그 레벨 (DEBUG, INFO, WARN, ERROR, 비어있으면 전체)
+	MinLevel      string `protobuf:"bytes,8,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailLogsRequest) Reset() {
+	*x = TailLogsRequest{}
+	mi := &file_log_streaming_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailLogsRequest) ProtoMessage() {}
+
+func (x *TailLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailLogsRequest.ProtoReflect.Descriptor instead.
+func (*TailLogsRequest) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TailLogsRequest) GetTarget() isTailLogsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TailLogsRequest) GetWorkerId() string {
+	if x != nil {
+		if x, ok := x.Target.(*TailLogsRequest_WorkerId); ok {
+			return x.WorkerId
+		}
+	}
+	return ""
+}
+
+func (x *TailLogsRequest) GetTaskId() string {
+	if x != nil {
+		if x, ok := x.Target.(*TailLogsRequest_TaskId); ok {
+			return x.TaskId
+		}
+	}
+	return ""
+}
+
+func (x *TailLogsRequest) GetPipelineId() string {
+	if x != nil {
+		if x, ok := x.Target.(*TailLogsRequest_PipelineId); ok {
+			return x.PipelineId
+		}
+	}
+	return ""
+}
+
+func (x *TailLogsRequest) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *TailLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *TailLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *TailLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *TailLogsRequest) GetMinLevel() string {
+	if x != nil {
+		return x.MinLevel
+	}
+	return ""
+}
+
+type isTailLogsRequest_Target interface {
+	isTailLogsRequest_Target()
+}
+
+type TailLogsRequest_WorkerId struct {
+	// 특정 Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof"`
+}
+
+type TailLogsRequest_TaskId struct {
+	// 작업 ID (작업의 모든 Worker)
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3,oneof"`
+}
+
+type TailLogsRequest_PipelineId struct {
+	// Pipeline ID (stage_id로 Stage 한정 가능)
+	PipelineId string `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3,oneof"`
+}
+
+func (*TailLogsRequest_WorkerId) isTailLogsRequest_Target() {}
+
+func (*TailLogsRequest_TaskId) isTailLogsRequest_Target() {}
+
+func (*TailLogsRequest_PipelineId) isTailLogsRequest_Target() {}
+
+// SearchLogsRequest - 로그 검색 요청 (비어있는 조건은 적용하지 않음)
+type SearchLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 작업 ID
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Pipeline ID
+	PipelineId string `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	// Stage ID
+	StageId string `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// 특정 Worker Pod 이름들
+	WorkerIds []string `protobuf:"bytes,4,rep,name=worker_ids,json=workerIds,proto3" json:"worker_ids,omitempty"`
+	// 이 시간 이후의 로그만 (RFC3339 형식)
+	Since string `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	// 이 시간 이전의 로그만 (RFC3339 형식)
+	Until string `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// 검색어 (비어있으면 다른 조건에 맞는 모든 라인)
+	Query string `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	// query를 정규식(RE2 문법)으로 해석
+	Regex bool `protobuf:"varint,8,opt,name=regex,proto3" json:"regex,omitempty"`
+	// 대소문자 무시
+	IgnoreCase bool `protobuf:"varint,9,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// 최소 로그 레벨 (DEBUG, INFO, WARN, ERROR)
+	MinLevel string `protobuf:"bytes,10,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	// 결과마다 앞뒤로 함께 반환할 라인 수 (최대 20)
+	ContextLines int32 `protobuf:"varint,11,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+	// 페이지 크기 (0이면 100, 최대 1000)
+	Limit int32 `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 이전 응답의 next_page_token
+	PageToken     string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsRequest) Reset() {
+	*x = SearchLogsRequest{}
+	mi := &file_log_streaming_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsRequest) ProtoMessage() {}
+
+func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchLogsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetWorkerIds() []string {
+	if x != nil {
+		return x.WorkerIds
+	}
+	return nil
+}
+
+func (x *SearchLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *SearchLogsRequest) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *SearchLogsRequest) GetMinLevel() string {
+	if x != nil {
+		return x.MinLevel
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetContextLines() int32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchLogsResponse - 로그 검색 응답
+type SearchLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색 결과 (작업, Worker, 라인 순)
+	Matches []*LogSearchMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// 다음 페이지 토큰 (비어있으면 마지막 페이지)
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 후보 라인을 읽은 세그먼트 수
+	ScannedSegments int32 `protobuf:"varint,3,opt,name=scanned_segments,json=scannedSegments,proto3" json:"scanned_segments,omitempty"`
+	// 시간 범위, 레벨, 역색인으로 건너뛴 세그먼트 수
+	SkippedSegments int32 `protobuf:"varint,4,opt,name=skipped_segments,json=skippedSegments,proto3" json:"skipped_segments,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchLogsResponse) Reset() {
+	*x = SearchLogsResponse{}
+	mi := &file_log_streaming_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsResponse) ProtoMessage() {}
+
+func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchLogsResponse) GetMatches() []*LogSearchMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchLogsResponse) GetScannedSegments() int32 {
+	if x != nil {
+		return x.ScannedSegments
+	}
+	return 0
+}
+
+func (x *SearchLogsResponse) GetSkippedSegments() int32 {
+	if x != nil {
+		return x.SkippedSegments
+	}
+	return 0
+}
+
+// LogSearchMatch - 검색 결과 하나
+type LogSearchMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// 작업 ID
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Pipeline ID (Pipeline Worker인 경우)
+	PipelineId string `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	// Stage ID (Pipeline Worker인 경우)
+	StageId string `protobuf:"bytes,4,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// 일치한 라인
+	Line *SearchLogLine `protobuf:"bytes,5,opt,name=line,proto3" json:"line,omitempty"`
+	// 앞쪽 문맥 라인 (오래된 순)
+	Before []*SearchLogLine `protobuf:"bytes,6,rep,name=before,proto3" json:"before,omitempty"`
+	// 뒤쪽 문맥 라인
+	After         []*SearchLogLine `protobuf:"bytes,7,rep,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogSearchMatch) Reset() {
+	*x = LogSearchMatch{}
+	mi := &file_log_streaming_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogSearchMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSearchMatch) ProtoMessage() {}
+
+func (x *LogSearchMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSearchMatch.ProtoReflect.Descriptor instead.
+func (*LogSearchMatch) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LogSearchMatch) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *LogSearchMatch) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *LogSearchMatch) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *LogSearchMatch) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *LogSearchMatch) GetLine() *SearchLogLine {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *LogSearchMatch) GetBefore() []*SearchLogLine {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *LogSearchMatch) GetAfter() []*SearchLogLine {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// SearchLogLine - 검색 결과의 로그 라인
+type SearchLogLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker 로그 안의 라인 번호 (0부터 시작)
+	Line int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// 로그 시간 (RFC3339 형식)
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 로그 레벨
+	Level string `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	// 로그 소스 (stdout, stderr 또는 컨테이너 이름)
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// 로그 메시지
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogLine) Reset() {
+	*x = SearchLogLine{}
+	mi := &file_log_streaming_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogLine) ProtoMessage() {}
+
+func (x *SearchLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogLine.ProtoReflect.Descriptor instead.
+func (*SearchLogLine) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchLogLine) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SearchLogLine) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *SearchLogLine) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SearchLogLine) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SearchLogLine) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// WorkerLogEntry - Ottoscaler에서 Otto-handler로 전달하는 Worker 로그 엔트리
+type WorkerLogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker Pod의 고유 식별자
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// 작업 식별자 (CI/CD 작업 ID)
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// 로그 생성 시간 (RFC3339 형식)
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 로그 레벨 (INFO, ERROR, DEBUG, WARN)
+	Level string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	// 로그 소스 (stdout, stderr)
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// 실제 로그 메시지 내용
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// Pod 관련 메타데이터
+	PodMetadata *WorkerMetadata `protobuf:"bytes,7,opt,name=pod_metadata,json=podMetadata,proto3" json:"pod_metadata,omitempty"`
+	// 추가 메타데이터
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Worker별 전달 순번 (1부터 증가, 0이면 미지정)
+	// 디스크 스풀에서 재전송된 엔트리는 같은 순번을 가지므로 Otto-handler가 중복을 제거할 수 있음
+	Sequence      int64 `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerLogEntry) Reset() {
+	*x = WorkerLogEntry{}
+	mi := &file_log_streaming_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerLogEntry) ProtoMessage() {}
+
+func (x *WorkerLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerLogEntry.ProtoReflect.Descriptor instead.
+func (*WorkerLogEntry) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WorkerLogEntry) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkerLogEntry) GetPodMetadata() *WorkerMetadata {
+	if x != nil {
+		return x.PodMetadata
+	}
+	return nil
+}
+
+func (x *WorkerLogEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WorkerLogEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// WorkerLogBatch - 한 Worker의 로그 엔트리 묶음
+type WorkerLogBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// 작업 ID
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// 배치 시퀀스 번호 (Worker 스트림별 1부터 증가)
+	Sequence int64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// 로그 엔트리 (수집 순서)
+	Entries       []*WorkerLogEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerLogBatch) Reset() {
+	*x = WorkerLogBatch{}
+	mi := &file_log_streaming_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerLogBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerLogBatch) ProtoMessage() {}
+
+func (x *WorkerLogBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerLogBatch.ProtoReflect.Descriptor instead.
+func (*WorkerLogBatch) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorkerLogBatch) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *WorkerLogBatch) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WorkerLogBatch) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WorkerLogBatch) GetEntries() []*WorkerLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// LogForwardResponse - Otto-handler에서 Ottoscaler로의 로그 처리 응답
+type LogForwardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 로그 처리 결과 상태
+	Status LogForwardResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ottoscaler.v1.LogForwardResponse_Status" json:"status,omitempty"`
+	// 응답 메시지 (에러 정보 또는 성공 메시지)
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 응답 대상의 시퀀스 번호 (WorkerLogEntry.sequence 또는 WorkerLogBatch.sequence)
+	// ottoscaler는 이 값으로 in-flight 로그를 찾아 ACK는 해제, RETRY는 재전송, DROP은 폐기합니다
+	Sequence int64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// 백프레셔 제어: 전송 간격 (ms). 0보다 크면 ottoscaler는 해당 Worker의 전송을
+	// 이 간격으로 제한하고, 0이 오면 제한을 해제합니다
+	ThrottleMs int32 `protobuf:"varint,4,opt,name=throttle_ms,json=throttleMs,proto3" json:"throttle_ms,omitempty"`
+	// 응답 대상 Worker ID (여러 Worker가 하나의 스트림을 공유하는 멀티플렉싱 모드용)
+	// 비어있으면 ottoscaler는 같은 sequence로 가장 먼저 보낸 Worker의 응답으로 처리합니다
+	WorkerId      string `protobuf:"bytes,5,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogForwardResponse) Reset() {
+	*x = LogForwardResponse{}
+	mi := &file_log_streaming_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogForwardResponse) ProtoMessage() {}
+
+func (x *LogForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogForwardResponse.ProtoReflect.Descriptor instead.
+func (*LogForwardResponse) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogForwardResponse) GetStatus() LogForwardResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return LogForwardResponse_ACK
+}
+
+func (x *LogForwardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogForwardResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LogForwardResponse) GetThrottleMs() int32 {
+	if x != nil {
+		return x.ThrottleMs
+	}
+	return 0
+}
+
+func (x *LogForwardResponse) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+// WorkerStatusNotification - Worker Pod 상태 변경 알림
+type WorkerStatusNotification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worker Pod 이름
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// 작업 ID
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// 상태 타입
+	Status WorkerStatusNotification_StatusType `protobuf:"varint,3,opt,name=status,proto3,enum=ottoscaler.v1.WorkerStatusNotification_StatusType" json:"status,omitempty"`
+	// 상태 변경 시간
+	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 상태 변경 이유/메시지
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 추가 메타데이터
+	Metadata      map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerStatusNotification) Reset() {
+	*x = WorkerStatusNotification{}
+	mi := &file_log_streaming_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerStatusNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerStatusNotification) ProtoMessage() {}
+
+func (x *WorkerStatusNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerStatusNotification.ProtoReflect.Descriptor instead.
+func (*WorkerStatusNotification) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WorkerStatusNotification) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *WorkerStatusNotification) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WorkerStatusNotification) GetStatus() WorkerStatusNotification_StatusType {
+	if x != nil {
+		return x.Status
+	}
+	return WorkerStatusNotification_CREATED
+}
+
+func (x *WorkerStatusNotification) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *WorkerStatusNotification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorkerStatusNotification) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// WorkerStatusAck - Worker 상태 알림에 대한 응답
+type WorkerStatusAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        WorkerStatusAck_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ottoscaler.v1.WorkerStatusAck_Status" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerStatusAck) Reset() {
+	*x = WorkerStatusAck{}
+	mi := &file_log_streaming_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerStatusAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerStatusAck) ProtoMessage() {}
+
+func (x *WorkerStatusAck) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerStatusAck.ProtoReflect.Descriptor instead.
+func (*WorkerStatusAck) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WorkerStatusAck) GetStatus() WorkerStatusAck_Status {
+	if x != nil {
+		return x.Status
 	}
 	return WorkerStatusAck_RECEIVED
 }
@@ -1720,7 +2972,7 @@ type PipelineRequest struct {
 
 func (x *PipelineRequest) Reset() {
 	*x = PipelineRequest{}
-	mi := &file_log_streaming_proto_msgTypes[15]
+	mi := &file_log_streaming_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1732,7 +2984,7 @@ func (x *PipelineRequest) String() string {
 func (*PipelineRequest) ProtoMessage() {}
 
 func (x *PipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_log_streaming_proto_msgTypes[15]
+	mi := &file_log_streaming_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,7 +2997,7 @@ func (x *PipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineRequest.ProtoReflect.Descriptor instead.
 func (*PipelineRequest) Descriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{15}
+	return file_log_streaming_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PipelineRequest) GetPipelineId() string {
@@ -1821,14 +3073,17 @@ type PipelineStage struct {
 	// 타임아웃 (초 단위, 0이면 무제한)
 	TimeoutSeconds int32 `protobuf:"varint,10,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	// 재시도 정책
-	RetryPolicy   *RetryPolicy `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	RetryPolicy *RetryPolicy `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// 각 Worker Pod에 사이드카로 함께 실행할 서비스 컨테이너 (DB, 캐시 등)
+	// Worker 컨테이너는 모든 서비스가 준비된 후에 시작됨
+	Services      []*ServiceContainer `protobuf:"bytes,12,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PipelineStage) Reset() {
 	*x = PipelineStage{}
-	mi := &file_log_streaming_proto_msgTypes[16]
+	mi := &file_log_streaming_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1840,7 +3095,7 @@ func (x *PipelineStage) String() string {
 func (*PipelineStage) ProtoMessage() {}
 
 func (x *PipelineStage) ProtoReflect() protoreflect.Message {
-	mi := &file_log_streaming_proto_msgTypes[16]
+	mi := &file_log_streaming_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +3108,7 @@ func (x *PipelineStage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineStage.ProtoReflect.Descriptor instead.
 func (*PipelineStage) Descriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{16}
+	return file_log_streaming_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PipelineStage) GetStageId() string {
@@ -1933,6 +3188,115 @@ func (x *PipelineStage) GetRetryPolicy() *RetryPolicy {
 	return nil
 }
 
+func (x *PipelineStage) GetServices() []*ServiceContainer {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// ServiceContainer - 테스트 Stage를 위한 서비스 컨테이너 (Postgres, Redis 등)
+type ServiceContainer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 서비스 이름 (예: "postgres", "redis")
+	// 컨테이너 이름은 "svc-<name>" 형식으로 생성됨
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 서비스 이미지 (예: "postgres:16-alpine")
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// 환경 변수
+	Env map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 노출할 컨테이너 포트
+	Ports []int32 `protobuf:"varint,4,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	// 준비 상태 확인 명령어 (예: ["pg_isready", "-U", "postgres"])
+	// 비어있으면 첫 번째 포트에 대한 TCP 확인을 사용
+	ReadinessCommand []string `protobuf:"bytes,5,rep,name=readiness_command,json=readinessCommand,proto3" json:"readiness_command,omitempty"`
+	// 실행할 명령어 (비어있으면 이미지 기본값)
+	Command []string `protobuf:"bytes,6,rep,name=command,proto3" json:"command,omitempty"`
+	// 명령어 인자
+	Args          []string `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceContainer) Reset() {
+	*x = ServiceContainer{}
+	mi := &file_log_streaming_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceContainer) ProtoMessage() {}
+
+func (x *ServiceContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_log_streaming_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceContainer.ProtoReflect.Descriptor instead.
+func (*ServiceContainer) Descriptor() ([]byte, []int) {
+	return file_log_streaming_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ServiceContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceContainer) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ServiceContainer) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ServiceContainer) GetPorts() []int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ServiceContainer) GetReadinessCommand() []string {
+	if x != nil {
+		return x.ReadinessCommand
+	}
+	return nil
+}
+
+func (x *ServiceContainer) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ServiceContainer) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
 // RetryPolicy - Stage 실패 시 재시도 정책
 type RetryPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1948,7 +3312,7 @@ type RetryPolicy struct {
 
 func (x *RetryPolicy) Reset() {
 	*x = RetryPolicy{}
-	mi := &file_log_streaming_proto_msgTypes[17]
+	mi := &file_log_streaming_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1960,7 +3324,7 @@ func (x *RetryPolicy) String() string {
 func (*RetryPolicy) ProtoMessage() {}
 
 func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_log_streaming_proto_msgTypes[17]
+	mi := &file_log_streaming_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +3337,7 @@ func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
 func (*RetryPolicy) Descriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{17}
+	return file_log_streaming_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RetryPolicy) GetMaxAttempts() int32 {
@@ -2021,14 +3385,16 @@ type PipelineProgress struct {
 	// 에러 정보 (실패한 경우)
 	ErrorMessage string `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	// Stage 메트릭
-	Metrics       *StageMetrics `protobuf:"bytes,11,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Metrics *StageMetrics `protobuf:"bytes,11,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// 이 Stage의 Worker들이 출력한 Step 요약 (Worker별 시작 순서)
+	Steps         []*StepSummary `protobuf:"bytes,12,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PipelineProgress) Reset() {
 	*x = PipelineProgress{}
-	mi := &file_log_streaming_proto_msgTypes[18]
+	mi := &file_log_streaming_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2040,7 +3406,7 @@ func (x *PipelineProgress) String() string {
 func (*PipelineProgress) ProtoMessage() {}
 
 func (x *PipelineProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_log_streaming_proto_msgTypes[18]
+	mi := &file_log_streaming_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +3419,7 @@ func (x *PipelineProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineProgress.ProtoReflect.Descriptor instead.
 func (*PipelineProgress) Descriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{18}
+	return file_log_streaming_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PipelineProgress) GetPipelineId() string {
@@ -2133,6 +3499,13 @@ func (x *PipelineProgress) GetMetrics() *StageMetrics {
 	return nil
 }
 
+func (x *PipelineProgress) GetSteps() []*StepSummary {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
 // StageMetrics - Stage 실행 메트릭
 type StageMetrics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2154,7 +3527,7 @@ type StageMetrics struct {
 
 func (x *StageMetrics) Reset() {
 	*x = StageMetrics{}
-	mi := &file_log_streaming_proto_msgTypes[19]
+	mi := &file_log_streaming_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2166,7 +3539,7 @@ func (x *StageMetrics) String() string {
 func (*StageMetrics) ProtoMessage() {}
 
 func (x *StageMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_log_streaming_proto_msgTypes[19]
+	mi := &file_log_streaming_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,7 +3552,7 @@ func (x *StageMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageMetrics.ProtoReflect.Descriptor instead.
 func (*StageMetrics) Descriptor() ([]byte, []int) {
-	return file_log_streaming_proto_rawDescGZIP(), []int{19}
+	return file_log_streaming_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *StageMetrics) GetDurationSeconds() int32 {
@@ -2228,7 +3601,7 @@ var File_log_streaming_proto protoreflect.FileDescriptor
 
 const file_log_streaming_proto_rawDesc = "" +
 	"\n" +
-	"\x13log_streaming.proto\x12\rottoscaler.v1\"\xa6\x02\n" +
+	"\x13log_streaming.proto\x12\rottoscaler.v1\"\xc5\x02\n" +
 	"\bLogEntry\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1c\n" +
@@ -2236,7 +3609,9 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\tR\x05level\x12\x16\n" +
 	"\x06source\x18\x05 \x01(\tR\x06source\x12\x18\n" +
 	"\amessage\x18\x06 \x01(\tR\amessage\x12A\n" +
-	"\bmetadata\x18\a \x03(\v2%.ottoscaler.v1.LogEntry.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\a \x03(\v2%.ottoscaler.v1.LogEntry.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\b \x01(\tR\tsessionId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\x01\n" +
@@ -2247,12 +3622,13 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\x06Status\x12\a\n" +
 	"\x03ACK\x10\x00\x12\t\n" +
 	"\x05RETRY\x10\x01\x12\b\n" +
-	"\x04DROP\x10\x02\"\xae\x01\n" +
+	"\x04DROP\x10\x02\"\xd7\x01\n" +
 	"\x12WorkerRegistration\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x129\n" +
 	"\bmetadata\x18\x03 \x01(\v2\x1d.ottoscaler.v1.WorkerMetadataR\bmetadata\x12'\n" +
-	"\x0fserver_endpoint\x18\x04 \x01(\tR\x0eserverEndpoint\"\x83\x02\n" +
+	"\x0fserver_endpoint\x18\x04 \x01(\tR\x0eserverEndpoint\x12'\n" +
+	"\x0fbootstrap_token\x18\x05 \x01(\tR\x0ebootstrapToken\"\x83\x02\n" +
 	"\x0eWorkerMetadata\x12\x19\n" +
 	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1b\n" +
@@ -2324,7 +3700,7 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\rpending_count\x18\x03 \x01(\x05R\fpendingCount\x12'\n" +
 	"\x0fsucceeded_count\x18\x04 \x01(\x05R\x0esucceededCount\x12!\n" +
 	"\ffailed_count\x18\x05 \x01(\x05R\vfailedCount\x128\n" +
-	"\aworkers\x18\x06 \x03(\v2\x1e.ottoscaler.v1.WorkerPodStatusR\aworkers\"\x96\x03\n" +
+	"\aworkers\x18\x06 \x03(\v2\x1e.ottoscaler.v1.WorkerPodStatusR\aworkers\"\xe5\x03\n" +
 	"\x0fWorkerPodStatus\x12\x19\n" +
 	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x16\n" +
@@ -2338,10 +3714,110 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\x06pod_ip\x18\b \x01(\tR\x05podIp\x12B\n" +
 	"\x06labels\x18\t \x03(\v2*.ottoscaler.v1.WorkerPodStatus.LabelsEntryR\x06labels\x12#\n" +
 	"\rerror_message\x18\n" +
-	" \x01(\tR\ferrorMessage\x1a9\n" +
+	" \x01(\tR\ferrorMessage\x12\x1b\n" +
+	"\tcache_hit\x18\v \x01(\bR\bcacheHit\x120\n" +
+	"\x05steps\x18\f \x03(\v2\x1a.ottoscaler.v1.StepSummaryR\x05steps\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf4\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf4\x01\n" +
+	"\vStepSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tworker_id\x18\x02 \x01(\tR\bworkerId\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\texit_code\x18\x05 \x01(\x05R\bexitCode\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\a \x01(\tR\vcompletedAt\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\"\x98\x02\n" +
+	"\x14GetWorkerLogsRequest\x12\x1d\n" +
+	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x12\x19\n" +
+	"\atask_id\x18\x02 \x01(\tH\x00R\x06taskId\x12!\n" +
+	"\vpipeline_id\x18\x03 \x01(\tH\x00R\n" +
+	"pipelineId\x12\x19\n" +
+	"\bstage_id\x18\x04 \x01(\tR\astageId\x12-\n" +
+	"\x05range\x18\x05 \x01(\v2\x17.ottoscaler.v1.LogRangeR\x05range\x120\n" +
+	"\x06format\x18\x06 \x01(\x0e2\x18.ottoscaler.v1.LogFormatR\x06format\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenB\b\n" +
+	"\x06target\"d\n" +
+	"\bLogRange\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\tR\x05until\"\x97\x01\n" +
+	"\x15GetWorkerLogsResponse\x12;\n" +
+	"\aworkers\x18\x01 \x03(\v2!.ottoscaler.v1.ArchivedWorkerLogsR\aworkers\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb4\x02\n" +
+	"\x12ArchivedWorkerLogs\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bstage_id\x18\x04 \x01(\tR\astageId\x12\x1f\n" +
+	"\vtotal_lines\x18\x05 \x01(\x03R\n" +
+	"totalLines\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x03R\x06offset\x12\x1d\n" +
+	"\n" +
+	"line_count\x18\a \x01(\x05R\tlineCount\x12\x19\n" +
+	"\bhas_more\x18\b \x01(\bR\ahasMore\x12\x18\n" +
+	"\acontent\x18\t \x01(\tR\acontent\x12\x1f\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\tR\n" +
+	"archivedAt\"\xfd\x01\n" +
+	"\x0fTailLogsRequest\x12\x1d\n" +
+	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x12\x19\n" +
+	"\atask_id\x18\x02 \x01(\tH\x00R\x06taskId\x12!\n" +
+	"\vpipeline_id\x18\x03 \x01(\tH\x00R\n" +
+	"pipelineId\x12\x19\n" +
+	"\bstage_id\x18\x04 \x01(\tR\astageId\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\bR\x06follow\x12\x14\n" +
+	"\x05since\x18\x06 \x01(\tR\x05since\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\a \x01(\x03R\ttailLines\x12\x1b\n" +
+	"\tmin_level\x18\b \x01(\tR\bminLevelB\b\n" +
+	"\x06target\"\xf7\x02\n" +
+	"\x11SearchLogsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bstage_id\x18\x03 \x01(\tR\astageId\x12\x1d\n" +
+	"\n" +
+	"worker_ids\x18\x04 \x03(\tR\tworkerIds\x12\x14\n" +
+	"\x05since\x18\x05 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x06 \x01(\tR\x05until\x12\x14\n" +
+	"\x05query\x18\a \x01(\tR\x05query\x12\x14\n" +
+	"\x05regex\x18\b \x01(\bR\x05regex\x12\x1f\n" +
+	"\vignore_case\x18\t \x01(\bR\n" +
+	"ignoreCase\x12\x1b\n" +
+	"\tmin_level\x18\n" +
+	" \x01(\tR\bminLevel\x12#\n" +
+	"\rcontext_lines\x18\v \x01(\x05R\fcontextLines\x12\x14\n" +
+	"\x05limit\x18\f \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\"\xcb\x01\n" +
+	"\x12SearchLogsResponse\x127\n" +
+	"\amatches\x18\x01 \x03(\v2\x1d.ottoscaler.v1.LogSearchMatchR\amatches\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12)\n" +
+	"\x10scanned_segments\x18\x03 \x01(\x05R\x0fscannedSegments\x12)\n" +
+	"\x10skipped_segments\x18\x04 \x01(\x05R\x0fskippedSegments\"\x9e\x02\n" +
+	"\x0eLogSearchMatch\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bstage_id\x18\x04 \x01(\tR\astageId\x120\n" +
+	"\x04line\x18\x05 \x01(\v2\x1c.ottoscaler.v1.SearchLogLineR\x04line\x124\n" +
+	"\x06before\x18\x06 \x03(\v2\x1c.ottoscaler.v1.SearchLogLineR\x06before\x122\n" +
+	"\x05after\x18\a \x03(\v2\x1c.ottoscaler.v1.SearchLogLineR\x05after\"\x89\x01\n" +
+	"\rSearchLogLine\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x90\x03\n" +
 	"\x0eWorkerLogEntry\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1c\n" +
@@ -2350,16 +3826,23 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\x06source\x18\x05 \x01(\tR\x06source\x12\x18\n" +
 	"\amessage\x18\x06 \x01(\tR\amessage\x12@\n" +
 	"\fpod_metadata\x18\a \x01(\v2\x1d.ottoscaler.v1.WorkerMetadataR\vpodMetadata\x12G\n" +
-	"\bmetadata\x18\b \x03(\v2+.ottoscaler.v1.WorkerLogEntry.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\b \x03(\v2+.ottoscaler.v1.WorkerLogEntry.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bsequence\x18\t \x01(\x03R\bsequence\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd5\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x01\n" +
+	"\x0eWorkerLogBatch\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x03R\bsequence\x127\n" +
+	"\aentries\x18\x04 \x03(\v2\x1d.ottoscaler.v1.WorkerLogEntryR\aentries\"\xf2\x01\n" +
 	"\x12LogForwardResponse\x12@\n" +
 	"\x06status\x18\x01 \x01(\x0e2(.ottoscaler.v1.LogForwardResponse.StatusR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
 	"\bsequence\x18\x03 \x01(\x03R\bsequence\x12\x1f\n" +
 	"\vthrottle_ms\x18\x04 \x01(\x05R\n" +
-	"throttleMs\"&\n" +
+	"throttleMs\x12\x1b\n" +
+	"\tworker_id\x18\x05 \x01(\tR\bworkerId\"&\n" +
 	"\x06Status\x12\a\n" +
 	"\x03ACK\x10\x00\x12\t\n" +
 	"\x05RETRY\x10\x01\x12\b\n" +
@@ -2404,7 +3887,7 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\bmetadata\x18\a \x03(\v2,.ottoscaler.v1.PipelineRequest.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfa\x03\n" +
 	"\rPipelineStage\x12\x19\n" +
 	"\bstage_id\x18\x01 \x01(\tR\astageId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
@@ -2418,14 +3901,26 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\x04args\x18\t \x03(\tR\x04args\x12'\n" +
 	"\x0ftimeout_seconds\x18\n" +
 	" \x01(\x05R\x0etimeoutSeconds\x12=\n" +
-	"\fretry_policy\x18\v \x01(\v2\x1a.ottoscaler.v1.RetryPolicyR\vretryPolicy\x1a9\n" +
+	"\fretry_policy\x18\v \x01(\v2\x1a.ottoscaler.v1.RetryPolicyR\vretryPolicy\x12;\n" +
+	"\bservices\x18\f \x03(\v2\x1f.ottoscaler.v1.ServiceContainerR\bservices\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x02\n" +
+	"\x10ServiceContainer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12:\n" +
+	"\x03env\x18\x03 \x03(\v2(.ottoscaler.v1.ServiceContainer.EnvEntryR\x03env\x12\x14\n" +
+	"\x05ports\x18\x04 \x03(\x05R\x05ports\x12+\n" +
+	"\x11readiness_command\x18\x05 \x03(\tR\x10readinessCommand\x12\x18\n" +
+	"\acommand\x18\x06 \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\a \x03(\tR\x04args\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
 	"\vRetryPolicy\x12!\n" +
 	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12.\n" +
 	"\x13retry_delay_seconds\x18\x02 \x01(\x05R\x11retryDelaySeconds\x12-\n" +
-	"\x12retryable_failures\x18\x03 \x03(\tR\x11retryableFailures\"\xb3\x03\n" +
+	"\x12retryable_failures\x18\x03 \x03(\tR\x11retryableFailures\"\xe5\x03\n" +
 	"\x10PipelineProgress\x12\x1f\n" +
 	"\vpipeline_id\x18\x01 \x01(\tR\n" +
 	"pipelineId\x12\x19\n" +
@@ -2440,14 +3935,18 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\x12#\n" +
 	"\rerror_message\x18\n" +
 	" \x01(\tR\ferrorMessage\x125\n" +
-	"\ametrics\x18\v \x01(\v2\x1b.ottoscaler.v1.StageMetricsR\ametrics\"\xfc\x01\n" +
+	"\ametrics\x18\v \x01(\v2\x1b.ottoscaler.v1.StageMetricsR\ametrics\x120\n" +
+	"\x05steps\x18\f \x03(\v2\x1a.ottoscaler.v1.StepSummaryR\x05steps\"\xfc\x01\n" +
 	"\fStageMetrics\x12)\n" +
 	"\x10duration_seconds\x18\x01 \x01(\x05R\x0fdurationSeconds\x12-\n" +
 	"\x12successful_workers\x18\x02 \x01(\x05R\x11successfulWorkers\x12%\n" +
 	"\x0efailed_workers\x18\x03 \x01(\x05R\rfailedWorkers\x12#\n" +
 	"\rtotal_workers\x18\x04 \x01(\x05R\ftotalWorkers\x12\"\n" +
 	"\ravg_cpu_usage\x18\x05 \x01(\x02R\vavgCpuUsage\x12\"\n" +
-	"\ravg_memory_mb\x18\x06 \x01(\x02R\vavgMemoryMb*\x96\x01\n" +
+	"\ravg_memory_mb\x18\x06 \x01(\x02R\vavgMemoryMb*5\n" +
+	"\tLogFormat\x12\x13\n" +
+	"\x0fLOG_FORMAT_TEXT\x10\x00\x12\x13\n" +
+	"\x0fLOG_FORMAT_JSON\x10\x01*\x96\x01\n" +
 	"\vStageStatus\x12\x11\n" +
 	"\rSTAGE_PENDING\x10\x00\x12\x11\n" +
 	"\rSTAGE_RUNNING\x10\x01\x12\x13\n" +
@@ -2455,14 +3954,19 @@ const file_log_streaming_proto_rawDesc = "" +
 	"\fSTAGE_FAILED\x10\x03\x12\x13\n" +
 	"\x0fSTAGE_CANCELLED\x10\x04\x12\x11\n" +
 	"\rSTAGE_SKIPPED\x10\x05\x12\x12\n" +
-	"\x0eSTAGE_RETRYING\x10\x062\xd3\x02\n" +
+	"\x0eSTAGE_RETRYING\x10\x062\xcf\x04\n" +
 	"\x11OttoscalerService\x12D\n" +
 	"\aScaleUp\x12\x1b.ottoscaler.v1.ScaleRequest\x1a\x1c.ottoscaler.v1.ScaleResponse\x12F\n" +
 	"\tScaleDown\x12\x1b.ottoscaler.v1.ScaleRequest\x1a\x1c.ottoscaler.v1.ScaleResponse\x12Z\n" +
 	"\x0fGetWorkerStatus\x12\".ottoscaler.v1.WorkerStatusRequest\x1a#.ottoscaler.v1.WorkerStatusResponse\x12T\n" +
-	"\x0fExecutePipeline\x12\x1e.ottoscaler.v1.PipelineRequest\x1a\x1f.ottoscaler.v1.PipelineProgress0\x012\xd1\x01\n" +
+	"\x0fExecutePipeline\x12\x1e.ottoscaler.v1.PipelineRequest\x1a\x1f.ottoscaler.v1.PipelineProgress0\x01\x12Z\n" +
+	"\rGetWorkerLogs\x12#.ottoscaler.v1.GetWorkerLogsRequest\x1a$.ottoscaler.v1.GetWorkerLogsResponse\x12K\n" +
+	"\bTailLogs\x12\x1e.ottoscaler.v1.TailLogsRequest\x1a\x1d.ottoscaler.v1.WorkerLogEntry0\x01\x12Q\n" +
+	"\n" +
+	"SearchLogs\x12 .ottoscaler.v1.SearchLogsRequest\x1a!.ottoscaler.v1.SearchLogsResponse2\xb2\x02\n" +
 	"\x15OttoHandlerLogService\x12Y\n" +
-	"\x11ForwardWorkerLogs\x12\x1d.ottoscaler.v1.WorkerLogEntry\x1a!.ottoscaler.v1.LogForwardResponse(\x010\x01\x12]\n" +
+	"\x11ForwardWorkerLogs\x12\x1d.ottoscaler.v1.WorkerLogEntry\x1a!.ottoscaler.v1.LogForwardResponse(\x010\x01\x12_\n" +
+	"\x17ForwardWorkerLogBatches\x12\x1d.ottoscaler.v1.WorkerLogBatch\x1a!.ottoscaler.v1.LogForwardResponse(\x010\x01\x12]\n" +
 	"\x12NotifyWorkerStatus\x12'.ottoscaler.v1.WorkerStatusNotification\x1a\x1e.ottoscaler.v1.WorkerStatusAck2\xb6\x01\n" +
 	"\x13LogStreamingService\x12E\n" +
 	"\n" +
@@ -2481,91 +3985,125 @@ func file_log_streaming_proto_rawDescGZIP() []byte {
 	return file_log_streaming_proto_rawDescData
 }
 
-var file_log_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_log_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_log_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_log_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_log_streaming_proto_goTypes = []any{
-	(StageStatus)(0),                         // 0: ottoscaler.v1.StageStatus
-	(LogResponse_Status)(0),                  // 1: ottoscaler.v1.LogResponse.Status
-	(RegistrationResponse_Status)(0),         // 2: ottoscaler.v1.RegistrationResponse.Status
-	(ScaleResponse_Status)(0),                // 3: ottoscaler.v1.ScaleResponse.Status
-	(LogForwardResponse_Status)(0),           // 4: ottoscaler.v1.LogForwardResponse.Status
-	(WorkerStatusNotification_StatusType)(0), // 5: ottoscaler.v1.WorkerStatusNotification.StatusType
-	(WorkerStatusAck_Status)(0),              // 6: ottoscaler.v1.WorkerStatusAck.Status
-	(*LogEntry)(nil),                         // 7: ottoscaler.v1.LogEntry
-	(*LogResponse)(nil),                      // 8: ottoscaler.v1.LogResponse
-	(*WorkerRegistration)(nil),               // 9: ottoscaler.v1.WorkerRegistration
-	(*WorkerMetadata)(nil),                   // 10: ottoscaler.v1.WorkerMetadata
-	(*RegistrationResponse)(nil),             // 11: ottoscaler.v1.RegistrationResponse
-	(*LoggingConfig)(nil),                    // 12: ottoscaler.v1.LoggingConfig
-	(*ScaleRequest)(nil),                     // 13: ottoscaler.v1.ScaleRequest
-	(*ScaleResponse)(nil),                    // 14: ottoscaler.v1.ScaleResponse
-	(*WorkerStatusRequest)(nil),              // 15: ottoscaler.v1.WorkerStatusRequest
-	(*WorkerStatusResponse)(nil),             // 16: ottoscaler.v1.WorkerStatusResponse
-	(*WorkerPodStatus)(nil),                  // 17: ottoscaler.v1.WorkerPodStatus
-	(*WorkerLogEntry)(nil),                   // 18: ottoscaler.v1.WorkerLogEntry
-	(*LogForwardResponse)(nil),               // 19: ottoscaler.v1.LogForwardResponse
-	(*WorkerStatusNotification)(nil),         // 20: ottoscaler.v1.WorkerStatusNotification
-	(*WorkerStatusAck)(nil),                  // 21: ottoscaler.v1.WorkerStatusAck
-	(*PipelineRequest)(nil),                  // 22: ottoscaler.v1.PipelineRequest
-	(*PipelineStage)(nil),                    // 23: ottoscaler.v1.PipelineStage
-	(*RetryPolicy)(nil),                      // 24: ottoscaler.v1.RetryPolicy
-	(*PipelineProgress)(nil),                 // 25: ottoscaler.v1.PipelineProgress
-	(*StageMetrics)(nil),                     // 26: ottoscaler.v1.StageMetrics
-	nil,                                      // 27: ottoscaler.v1.LogEntry.MetadataEntry
-	nil,                                      // 28: ottoscaler.v1.WorkerMetadata.LabelsEntry
-	nil,                                      // 29: ottoscaler.v1.ScaleRequest.BuildConfigEntry
-	nil,                                      // 30: ottoscaler.v1.ScaleRequest.MetadataEntry
-	nil,                                      // 31: ottoscaler.v1.WorkerPodStatus.LabelsEntry
-	nil,                                      // 32: ottoscaler.v1.WorkerLogEntry.MetadataEntry
-	nil,                                      // 33: ottoscaler.v1.WorkerStatusNotification.MetadataEntry
-	nil,                                      // 34: ottoscaler.v1.PipelineRequest.MetadataEntry
-	nil,                                      // 35: ottoscaler.v1.PipelineStage.ConfigEntry
+	(LogFormat)(0),                           // 0: ottoscaler.v1.LogFormat
+	(StageStatus)(0),                         // 1: ottoscaler.v1.StageStatus
+	(LogResponse_Status)(0),                  // 2: ottoscaler.v1.LogResponse.Status
+	(RegistrationResponse_Status)(0),         // 3: ottoscaler.v1.RegistrationResponse.Status
+	(ScaleResponse_Status)(0),                // 4: ottoscaler.v1.ScaleResponse.Status
+	(LogForwardResponse_Status)(0),           // 5: ottoscaler.v1.LogForwardResponse.Status
+	(WorkerStatusNotification_StatusType)(0), // 6: ottoscaler.v1.WorkerStatusNotification.StatusType
+	(WorkerStatusAck_Status)(0),              // 7: ottoscaler.v1.WorkerStatusAck.Status
+	(*LogEntry)(nil),                         // 8: ottoscaler.v1.LogEntry
+	(*LogResponse)(nil),                      // 9: ottoscaler.v1.LogResponse
+	(*WorkerRegistration)(nil),               // 10: ottoscaler.v1.WorkerRegistration
+	(*WorkerMetadata)(nil),                   // 11: ottoscaler.v1.WorkerMetadata
+	(*RegistrationResponse)(nil),             // 12: ottoscaler.v1.RegistrationResponse
+	(*LoggingConfig)(nil),                    // 13: ottoscaler.v1.LoggingConfig
+	(*ScaleRequest)(nil),                     // 14: ottoscaler.v1.ScaleRequest
+	(*ScaleResponse)(nil),                    // 15: ottoscaler.v1.ScaleResponse
+	(*WorkerStatusRequest)(nil),              // 16: ottoscaler.v1.WorkerStatusRequest
+	(*WorkerStatusResponse)(nil),             // 17: ottoscaler.v1.WorkerStatusResponse
+	(*WorkerPodStatus)(nil),                  // 18: ottoscaler.v1.WorkerPodStatus
+	(*StepSummary)(nil),                      // 19: ottoscaler.v1.StepSummary
+	(*GetWorkerLogsRequest)(nil),             // 20: ottoscaler.v1.GetWorkerLogsRequest
+	(*LogRange)(nil),                         // 21: ottoscaler.v1.LogRange
+	(*GetWorkerLogsResponse)(nil),            // 22: ottoscaler.v1.GetWorkerLogsResponse
+	(*ArchivedWorkerLogs)(nil),               // 23: ottoscaler.v1.ArchivedWorkerLogs
+	(*TailLogsRequest)(nil),                  // 24: ottoscaler.v1.TailLogsRequest
+	(*SearchLogsRequest)(nil),                // 25: ottoscaler.v1.SearchLogsRequest
+	(*SearchLogsResponse)(nil),               // 26: ottoscaler.v1.SearchLogsResponse
+	(*LogSearchMatch)(nil),                   // 27: ottoscaler.v1.LogSearchMatch
+	(*SearchLogLine)(nil),                    // 28: ottoscaler.v1.SearchLogLine
+	(*WorkerLogEntry)(nil),                   // 29: ottoscaler.v1.WorkerLogEntry
+	(*WorkerLogBatch)(nil),                   // 30: ottoscaler.v1.WorkerLogBatch
+	(*LogForwardResponse)(nil),               // 31: ottoscaler.v1.LogForwardResponse
+	(*WorkerStatusNotification)(nil),         // 32: ottoscaler.v1.WorkerStatusNotification
+	(*WorkerStatusAck)(nil),                  // 33: ottoscaler.v1.WorkerStatusAck
+	(*PipelineRequest)(nil),                  // 34: ottoscaler.v1.PipelineRequest
+	(*PipelineStage)(nil),                    // 35: ottoscaler.v1.PipelineStage
+	(*ServiceContainer)(nil),                 // 36: ottoscaler.v1.ServiceContainer
+	(*RetryPolicy)(nil),                      // 37: ottoscaler.v1.RetryPolicy
+	(*PipelineProgress)(nil),                 // 38: ottoscaler.v1.PipelineProgress
+	(*StageMetrics)(nil),                     // 39: ottoscaler.v1.StageMetrics
+	nil,                                      // 40: ottoscaler.v1.LogEntry.MetadataEntry
+	nil,                                      // 41: ottoscaler.v1.WorkerMetadata.LabelsEntry
+	nil,                                      // 42: ottoscaler.v1.ScaleRequest.BuildConfigEntry
+	nil,                                      // 43: ottoscaler.v1.ScaleRequest.MetadataEntry
+	nil,                                      // 44: ottoscaler.v1.WorkerPodStatus.LabelsEntry
+	nil,                                      // 45: ottoscaler.v1.WorkerLogEntry.MetadataEntry
+	nil,                                      // 46: ottoscaler.v1.WorkerStatusNotification.MetadataEntry
+	nil,                                      // 47: ottoscaler.v1.PipelineRequest.MetadataEntry
+	nil,                                      // 48: ottoscaler.v1.PipelineStage.ConfigEntry
+	nil,                                      // 49: ottoscaler.v1.ServiceContainer.EnvEntry
 }
 var file_log_streaming_proto_depIdxs = []int32{
-	27, // 0: ottoscaler.v1.LogEntry.metadata:type_name -> ottoscaler.v1.LogEntry.MetadataEntry
-	1,  // 1: ottoscaler.v1.LogResponse.status:type_name -> ottoscaler.v1.LogResponse.Status
-	10, // 2: ottoscaler.v1.WorkerRegistration.metadata:type_name -> ottoscaler.v1.WorkerMetadata
-	28, // 3: ottoscaler.v1.WorkerMetadata.labels:type_name -> ottoscaler.v1.WorkerMetadata.LabelsEntry
-	2,  // 4: ottoscaler.v1.RegistrationResponse.status:type_name -> ottoscaler.v1.RegistrationResponse.Status
-	12, // 5: ottoscaler.v1.RegistrationResponse.config:type_name -> ottoscaler.v1.LoggingConfig
-	29, // 6: ottoscaler.v1.ScaleRequest.build_config:type_name -> ottoscaler.v1.ScaleRequest.BuildConfigEntry
-	30, // 7: ottoscaler.v1.ScaleRequest.metadata:type_name -> ottoscaler.v1.ScaleRequest.MetadataEntry
-	3,  // 8: ottoscaler.v1.ScaleResponse.status:type_name -> ottoscaler.v1.ScaleResponse.Status
-	17, // 9: ottoscaler.v1.WorkerStatusResponse.workers:type_name -> ottoscaler.v1.WorkerPodStatus
-	31, // 10: ottoscaler.v1.WorkerPodStatus.labels:type_name -> ottoscaler.v1.WorkerPodStatus.LabelsEntry
-	10, // 11: ottoscaler.v1.WorkerLogEntry.pod_metadata:type_name -> ottoscaler.v1.WorkerMetadata
-	32, // 12: ottoscaler.v1.WorkerLogEntry.metadata:type_name -> ottoscaler.v1.WorkerLogEntry.MetadataEntry
-	4,  // 13: ottoscaler.v1.LogForwardResponse.status:type_name -> ottoscaler.v1.LogForwardResponse.Status
-	5,  // 14: ottoscaler.v1.WorkerStatusNotification.status:type_name -> ottoscaler.v1.WorkerStatusNotification.StatusType
-	33, // 15: ottoscaler.v1.WorkerStatusNotification.metadata:type_name -> ottoscaler.v1.WorkerStatusNotification.MetadataEntry
-	6,  // 16: ottoscaler.v1.WorkerStatusAck.status:type_name -> ottoscaler.v1.WorkerStatusAck.Status
-	23, // 17: ottoscaler.v1.PipelineRequest.stages:type_name -> ottoscaler.v1.PipelineStage
-	34, // 18: ottoscaler.v1.PipelineRequest.metadata:type_name -> ottoscaler.v1.PipelineRequest.MetadataEntry
-	35, // 19: ottoscaler.v1.PipelineStage.config:type_name -> ottoscaler.v1.PipelineStage.ConfigEntry
-	24, // 20: ottoscaler.v1.PipelineStage.retry_policy:type_name -> ottoscaler.v1.RetryPolicy
-	0,  // 21: ottoscaler.v1.PipelineProgress.status:type_name -> ottoscaler.v1.StageStatus
-	26, // 22: ottoscaler.v1.PipelineProgress.metrics:type_name -> ottoscaler.v1.StageMetrics
-	13, // 23: ottoscaler.v1.OttoscalerService.ScaleUp:input_type -> ottoscaler.v1.ScaleRequest
-	13, // 24: ottoscaler.v1.OttoscalerService.ScaleDown:input_type -> ottoscaler.v1.ScaleRequest
-	15, // 25: ottoscaler.v1.OttoscalerService.GetWorkerStatus:input_type -> ottoscaler.v1.WorkerStatusRequest
-	22, // 26: ottoscaler.v1.OttoscalerService.ExecutePipeline:input_type -> ottoscaler.v1.PipelineRequest
-	18, // 27: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogs:input_type -> ottoscaler.v1.WorkerLogEntry
-	20, // 28: ottoscaler.v1.OttoHandlerLogService.NotifyWorkerStatus:input_type -> ottoscaler.v1.WorkerStatusNotification
-	7,  // 29: ottoscaler.v1.LogStreamingService.StreamLogs:input_type -> ottoscaler.v1.LogEntry
-	9,  // 30: ottoscaler.v1.LogStreamingService.RegisterWorker:input_type -> ottoscaler.v1.WorkerRegistration
-	14, // 31: ottoscaler.v1.OttoscalerService.ScaleUp:output_type -> ottoscaler.v1.ScaleResponse
-	14, // 32: ottoscaler.v1.OttoscalerService.ScaleDown:output_type -> ottoscaler.v1.ScaleResponse
-	16, // 33: ottoscaler.v1.OttoscalerService.GetWorkerStatus:output_type -> ottoscaler.v1.WorkerStatusResponse
-	25, // 34: ottoscaler.v1.OttoscalerService.ExecutePipeline:output_type -> ottoscaler.v1.PipelineProgress
-	19, // 35: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogs:output_type -> ottoscaler.v1.LogForwardResponse
-	21, // 36: ottoscaler.v1.OttoHandlerLogService.NotifyWorkerStatus:output_type -> ottoscaler.v1.WorkerStatusAck
-	8,  // 37: ottoscaler.v1.LogStreamingService.StreamLogs:output_type -> ottoscaler.v1.LogResponse
-	11, // 38: ottoscaler.v1.LogStreamingService.RegisterWorker:output_type -> ottoscaler.v1.RegistrationResponse
-	31, // [31:39] is the sub-list for method output_type
-	23, // [23:31] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	40, // 0: ottoscaler.v1.LogEntry.metadata:type_name -> ottoscaler.v1.LogEntry.MetadataEntry
+	2,  // 1: ottoscaler.v1.LogResponse.status:type_name -> ottoscaler.v1.LogResponse.Status
+	11, // 2: ottoscaler.v1.WorkerRegistration.metadata:type_name -> ottoscaler.v1.WorkerMetadata
+	41, // 3: ottoscaler.v1.WorkerMetadata.labels:type_name -> ottoscaler.v1.WorkerMetadata.LabelsEntry
+	3,  // 4: ottoscaler.v1.RegistrationResponse.status:type_name -> ottoscaler.v1.RegistrationResponse.Status
+	13, // 5: ottoscaler.v1.RegistrationResponse.config:type_name -> ottoscaler.v1.LoggingConfig
+	42, // 6: ottoscaler.v1.ScaleRequest.build_config:type_name -> ottoscaler.v1.ScaleRequest.BuildConfigEntry
+	43, // 7: ottoscaler.v1.ScaleRequest.metadata:type_name -> ottoscaler.v1.ScaleRequest.MetadataEntry
+	4,  // 8: ottoscaler.v1.ScaleResponse.status:type_name -> ottoscaler.v1.ScaleResponse.Status
+	18, // 9: ottoscaler.v1.WorkerStatusResponse.workers:type_name -> ottoscaler.v1.WorkerPodStatus
+	44, // 10: ottoscaler.v1.WorkerPodStatus.labels:type_name -> ottoscaler.v1.WorkerPodStatus.LabelsEntry
+	19, // 11: ottoscaler.v1.WorkerPodStatus.steps:type_name -> ottoscaler.v1.StepSummary
+	21, // 12: ottoscaler.v1.GetWorkerLogsRequest.range:type_name -> ottoscaler.v1.LogRange
+	0,  // 13: ottoscaler.v1.GetWorkerLogsRequest.format:type_name -> ottoscaler.v1.LogFormat
+	23, // 14: ottoscaler.v1.GetWorkerLogsResponse.workers:type_name -> ottoscaler.v1.ArchivedWorkerLogs
+	27, // 15: ottoscaler.v1.SearchLogsResponse.matches:type_name -> ottoscaler.v1.LogSearchMatch
+	28, // 16: ottoscaler.v1.LogSearchMatch.line:type_name -> ottoscaler.v1.SearchLogLine
+	28, // 17: ottoscaler.v1.LogSearchMatch.before:type_name -> ottoscaler.v1.SearchLogLine
+	28, // 18: ottoscaler.v1.LogSearchMatch.after:type_name -> ottoscaler.v1.SearchLogLine
+	11, // 19: ottoscaler.v1.WorkerLogEntry.pod_metadata:type_name -> ottoscaler.v1.WorkerMetadata
+	45, // 20: ottoscaler.v1.WorkerLogEntry.metadata:type_name -> ottoscaler.v1.WorkerLogEntry.MetadataEntry
+	29, // 21: ottoscaler.v1.WorkerLogBatch.entries:type_name -> ottoscaler.v1.WorkerLogEntry
+	5,  // 22: ottoscaler.v1.LogForwardResponse.status:type_name -> ottoscaler.v1.LogForwardResponse.Status
+	6,  // 23: ottoscaler.v1.WorkerStatusNotification.status:type_name -> ottoscaler.v1.WorkerStatusNotification.StatusType
+	46, // 24: ottoscaler.v1.WorkerStatusNotification.metadata:type_name -> ottoscaler.v1.WorkerStatusNotification.MetadataEntry
+	7,  // 25: ottoscaler.v1.WorkerStatusAck.status:type_name -> ottoscaler.v1.WorkerStatusAck.Status
+	35, // 26: ottoscaler.v1.PipelineRequest.stages:type_name -> ottoscaler.v1.PipelineStage
+	47, // 27: ottoscaler.v1.PipelineRequest.metadata:type_name -> ottoscaler.v1.PipelineRequest.MetadataEntry
+	48, // 28: ottoscaler.v1.PipelineStage.config:type_name -> ottoscaler.v1.PipelineStage.ConfigEntry
+	37, // 29: ottoscaler.v1.PipelineStage.retry_policy:type_name -> ottoscaler.v1.RetryPolicy
+	36, // 30: ottoscaler.v1.PipelineStage.services:type_name -> ottoscaler.v1.ServiceContainer
+	49, // 31: ottoscaler.v1.ServiceContainer.env:type_name -> ottoscaler.v1.ServiceContainer.EnvEntry
+	1,  // 32: ottoscaler.v1.PipelineProgress.status:type_name -> ottoscaler.v1.StageStatus
+	39, // 33: ottoscaler.v1.PipelineProgress.metrics:type_name -> ottoscaler.v1.StageMetrics
+	19, // 34: ottoscaler.v1.PipelineProgress.steps:type_name -> ottoscaler.v1.StepSummary
+	14, // 35: ottoscaler.v1.OttoscalerService.ScaleUp:input_type -> ottoscaler.v1.ScaleRequest
+	14, // 36: ottoscaler.v1.OttoscalerService.ScaleDown:input_type -> ottoscaler.v1.ScaleRequest
+	16, // 37: ottoscaler.v1.OttoscalerService.GetWorkerStatus:input_type -> ottoscaler.v1.WorkerStatusRequest
+	34, // 38: ottoscaler.v1.OttoscalerService.ExecutePipeline:input_type -> ottoscaler.v1.PipelineRequest
+	20, // 39: ottoscaler.v1.OttoscalerService.GetWorkerLogs:input_type -> ottoscaler.v1.GetWorkerLogsRequest
+	24, // 40: ottoscaler.v1.OttoscalerService.TailLogs:input_type -> ottoscaler.v1.TailLogsRequest
+	25, // 41: ottoscaler.v1.OttoscalerService.SearchLogs:input_type -> ottoscaler.v1.SearchLogsRequest
+	29, // 42: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogs:input_type -> ottoscaler.v1.WorkerLogEntry
+	30, // 43: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogBatches:input_type -> ottoscaler.v1.WorkerLogBatch
+	32, // 44: ottoscaler.v1.OttoHandlerLogService.NotifyWorkerStatus:input_type -> ottoscaler.v1.WorkerStatusNotification
+	8,  // 45: ottoscaler.v1.LogStreamingService.StreamLogs:input_type -> ottoscaler.v1.LogEntry
+	10, // 46: ottoscaler.v1.LogStreamingService.RegisterWorker:input_type -> ottoscaler.v1.WorkerRegistration
+	15, // 47: ottoscaler.v1.OttoscalerService.ScaleUp:output_type -> ottoscaler.v1.ScaleResponse
+	15, // 48: ottoscaler.v1.OttoscalerService.ScaleDown:output_type -> ottoscaler.v1.ScaleResponse
+	17, // 49: ottoscaler.v1.OttoscalerService.GetWorkerStatus:output_type -> ottoscaler.v1.WorkerStatusResponse
+	38, // 50: ottoscaler.v1.OttoscalerService.ExecutePipeline:output_type -> ottoscaler.v1.PipelineProgress
+	22, // 51: ottoscaler.v1.OttoscalerService.GetWorkerLogs:output_type -> ottoscaler.v1.GetWorkerLogsResponse
+	29, // 52: ottoscaler.v1.OttoscalerService.TailLogs:output_type -> ottoscaler.v1.WorkerLogEntry
+	26, // 53: ottoscaler.v1.OttoscalerService.SearchLogs:output_type -> ottoscaler.v1.SearchLogsResponse
+	31, // 54: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogs:output_type -> ottoscaler.v1.LogForwardResponse
+	31, // 55: ottoscaler.v1.OttoHandlerLogService.ForwardWorkerLogBatches:output_type -> ottoscaler.v1.LogForwardResponse
+	33, // 56: ottoscaler.v1.OttoHandlerLogService.NotifyWorkerStatus:output_type -> ottoscaler.v1.WorkerStatusAck
+	9,  // 57: ottoscaler.v1.LogStreamingService.StreamLogs:output_type -> ottoscaler.v1.LogResponse
+	12, // 58: ottoscaler.v1.LogStreamingService.RegisterWorker:output_type -> ottoscaler.v1.RegistrationResponse
+	47, // [47:59] is the sub-list for method output_type
+	35, // [35:47] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_log_streaming_proto_init() }
@@ -2573,13 +4111,23 @@ func file_log_streaming_proto_init() {
 	if File_log_streaming_proto != nil {
 		return
 	}
+	file_log_streaming_proto_msgTypes[12].OneofWrappers = []any{
+		(*GetWorkerLogsRequest_WorkerId)(nil),
+		(*GetWorkerLogsRequest_TaskId)(nil),
+		(*GetWorkerLogsRequest_PipelineId)(nil),
+	}
+	file_log_streaming_proto_msgTypes[16].OneofWrappers = []any{
+		(*TailLogsRequest_WorkerId)(nil),
+		(*TailLogsRequest_TaskId)(nil),
+		(*TailLogsRequest_PipelineId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_log_streaming_proto_rawDesc), len(file_log_streaming_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   29,
+			NumEnums:      8,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -23,6 +23,9 @@ const (
 	OttoscalerService_ScaleDown_FullMethodName       = "/ottoscaler.v1.OttoscalerService/ScaleDown"
 	OttoscalerService_GetWorkerStatus_FullMethodName = "/ottoscaler.v1.OttoscalerService/GetWorkerStatus"
 	OttoscalerService_ExecutePipeline_FullMethodName = "/ottoscaler.v1.OttoscalerService/ExecutePipeline"
+	OttoscalerService_GetWorkerLogs_FullMethodName   = "/ottoscaler.v1.OttoscalerService/GetWorkerLogs"
+	OttoscalerService_TailLogs_FullMethodName        = "/ottoscaler.v1.OttoscalerService/TailLogs"
+	OttoscalerService_SearchLogs_FullMethodName      = "/ottoscaler.v1.OttoscalerService/SearchLogs"
 )
 
 // OttoscalerServiceClient is the client API for OttoscalerService service.
@@ -66,6 +69,29 @@ type OttoscalerServiceClient interface {
 	// - Ottoscaler가 Stage 의존성을 파악하여 순차/병렬 실행
 	// - 실시간 진행 상황을 스트리밍으로 반환
 	ExecutePipeline(ctx context.Context, in *PipelineRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PipelineProgress], error)
+	// GetWorkerLogs - 보관된 Worker 로그 조회
+	//
+	// 📝 동작 방식:
+	// - Worker Pod가 삭제된 뒤에도 로그 아카이브에서 전체 로그를 조회
+	// - Worker, 작업(Task), Pipeline/Stage 단위로 조회 가능
+	// - 라인/시간 범위와 응답 형식(텍스트, JSON Lines) 지정 가능
+	GetWorkerLogs(ctx context.Context, in *GetWorkerLogsRequest, opts ...grpc.CallOption) (*GetWorkerLogsResponse, error)
+	// TailLogs - Worker/작업/Pipeline 로그 실시간 구독
+	//
+	// 📝 동작 방식:
+	// - 이미 삭제된 Worker의 로그는 아카이브에서, 실행 중인 Worker는 Pod 로그에서 읽음
+	// - 여러 Worker의 로그를 하나의 스트림으로 전달 (metadata에 pipeline_id, stage_id 태깅)
+	// - follow=false면 시간순으로 정렬하여 보낸 뒤 종료
+	// - follow=true면 새 로그와 새로 생성되는 Worker를 계속 전달 (클라이언트가 취소할 때까지)
+	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkerLogEntry], error)
+	// SearchLogs - 실행 중인 Worker와 보관된 Worker의 로그 검색
+	//
+	// 📝 동작 방식:
+	// - 로그를 수집하면서 만든 로컬 색인(세그먼트별 역색인)에서 검색하므로 파일을 매번 전체 스캔하지 않음
+	// - 작업(Task), Pipeline/Stage, Worker, 시간 범위, 최소 레벨로 대상을 한정
+	// - 부분 문자열 또는 정규식으로 검색하고, 결과마다 앞뒤 문맥 라인을 함께 반환
+	// - 결과가 더 있으면 next_page_token으로 이어서 조회
+	SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error)
 }
 
 type ottoscalerServiceClient struct {
@@ -125,6 +151,45 @@ func (c *ottoscalerServiceClient) ExecutePipeline(ctx context.Context, in *Pipel
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OttoscalerService_ExecutePipelineClient = grpc.ServerStreamingClient[PipelineProgress]
 
+func (c *ottoscalerServiceClient) GetWorkerLogs(ctx context.Context, in *GetWorkerLogsRequest, opts ...grpc.CallOption) (*GetWorkerLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerLogsResponse)
+	err := c.cc.Invoke(ctx, OttoscalerService_GetWorkerLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ottoscalerServiceClient) TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkerLogEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OttoscalerService_ServiceDesc.Streams[1], OttoscalerService_TailLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailLogsRequest, WorkerLogEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OttoscalerService_TailLogsClient = grpc.ServerStreamingClient[WorkerLogEntry]
+
+func (c *ottoscalerServiceClient) SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLogsResponse)
+	err := c.cc.Invoke(ctx, OttoscalerService_SearchLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OttoscalerServiceServer is the server API for OttoscalerService service.
 // All implementations must embed UnimplementedOttoscalerServiceServer
 // for forward compatibility.
@@ -166,6 +231,29 @@ type OttoscalerServiceServer interface {
 	// - Ottoscaler가 Stage 의존성을 파악하여 순차/병렬 실행
 	// - 실시간 진행 상황을 스트리밍으로 반환
 	ExecutePipeline(*PipelineRequest, grpc.ServerStreamingServer[PipelineProgress]) error
+	// GetWorkerLogs - 보관된 Worker 로그 조회
+	//
+	// 📝 동작 방식:
+	// - Worker Pod가 삭제된 뒤에도 로그 아카이브에서 전체 로그를 조회
+	// - Worker, 작업(Task), Pipeline/Stage 단위로 조회 가능
+	// - 라인/시간 범위와 응답 형식(텍스트, JSON Lines) 지정 가능
+	GetWorkerLogs(context.Context, *GetWorkerLogsRequest) (*GetWorkerLogsResponse, error)
+	// TailLogs - Worker/작업/Pipeline 로그 실시간 구독
+	//
+	// 📝 동작 방식:
+	// - 이미 삭제된 Worker의 로그는 아카이브에서, 실행 중인 Worker는 Pod 로그에서 읽음
+	// - 여러 Worker의 로그를 하나의 스트림으로 전달 (metadata에 pipeline_id, stage_id 태깅)
+	// - follow=false면 시간순으로 정렬하여 보낸 뒤 종료
+	// - follow=true면 새 로그와 새로 생성되는 Worker를 계속 전달 (클라이언트가 취소할 때까지)
+	TailLogs(*TailLogsRequest, grpc.ServerStreamingServer[WorkerLogEntry]) error
+	// SearchLogs - 실행 중인 Worker와 보관된 Worker의 로그 검색
+	//
+	// 📝 동작 방식:
+	// - 로그를 수집하면서 만든 로컬 색인(세그먼트별 역색인)에서 검색하므로 파일을 매번 전체 스캔하지 않음
+	// - 작업(Task), Pipeline/Stage, Worker, 시간 범위, 최소 레벨로 대상을 한정
+	// - 부분 문자열 또는 정규식으로 검색하고, 결과마다 앞뒤 문맥 라인을 함께 반환
+	// - 결과가 더 있으면 next_page_token으로 이어서 조회
+	SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error)
 	mustEmbedUnimplementedOttoscalerServiceServer()
 }
 
@@ -188,6 +276,15 @@ func (UnimplementedOttoscalerServiceServer) GetWorkerStatus(context.Context, *Wo
 func (UnimplementedOttoscalerServiceServer) ExecutePipeline(*PipelineRequest, grpc.ServerStreamingServer[PipelineProgress]) error {
 	return status.Errorf(codes.Unimplemented, "method ExecutePipeline not implemented")
 }
+func (UnimplementedOttoscalerServiceServer) GetWorkerLogs(context.Context, *GetWorkerLogsRequest) (*GetWorkerLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLogs not implemented")
+}
+func (UnimplementedOttoscalerServiceServer) TailLogs(*TailLogsRequest, grpc.ServerStreamingServer[WorkerLogEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
+func (UnimplementedOttoscalerServiceServer) SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+}
 func (UnimplementedOttoscalerServiceServer) mustEmbedUnimplementedOttoscalerServiceServer() {}
 func (UnimplementedOttoscalerServiceServer) testEmbeddedByValue()                           {}
 
@@ -274,6 +371,53 @@ func _OttoscalerService_ExecutePipeline_Handler(srv interface{}, stream grpc.Ser
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OttoscalerService_ExecutePipelineServer = grpc.ServerStreamingServer[PipelineProgress]
 
+func _OttoscalerService_GetWorkerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OttoscalerServiceServer).GetWorkerLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OttoscalerService_GetWorkerLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OttoscalerServiceServer).GetWorkerLogs(ctx, req.(*GetWorkerLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OttoscalerService_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OttoscalerServiceServer).TailLogs(m, &grpc.GenericServerStream[TailLogsRequest, WorkerLogEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OttoscalerService_TailLogsServer = grpc.ServerStreamingServer[WorkerLogEntry]
+
+func _OttoscalerService_SearchLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OttoscalerServiceServer).SearchLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OttoscalerService_SearchLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OttoscalerServiceServer).SearchLogs(ctx, req.(*SearchLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OttoscalerService_ServiceDesc is the grpc.ServiceDesc for OttoscalerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +437,14 @@ var OttoscalerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkerStatus",
 			Handler:    _OttoscalerService_GetWorkerStatus_Handler,
 		},
+		{
+			MethodName: "GetWorkerLogs",
+			Handler:    _OttoscalerService_GetWorkerLogs_Handler,
+		},
+		{
+			MethodName: "SearchLogs",
+			Handler:    _OttoscalerService_SearchLogs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -300,13 +452,19 @@ var OttoscalerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _OttoscalerService_ExecutePipeline_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TailLogs",
+			Handler:       _OttoscalerService_TailLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "log_streaming.proto",
 }
 
 const (
-	OttoHandlerLogService_ForwardWorkerLogs_FullMethodName  = "/ottoscaler.v1.OttoHandlerLogService/ForwardWorkerLogs"
-	OttoHandlerLogService_NotifyWorkerStatus_FullMethodName = "/ottoscaler.v1.OttoHandlerLogService/NotifyWorkerStatus"
+	OttoHandlerLogService_ForwardWorkerLogs_FullMethodName       = "/ottoscaler.v1.OttoHandlerLogService/ForwardWorkerLogs"
+	OttoHandlerLogService_ForwardWorkerLogBatches_FullMethodName = "/ottoscaler.v1.OttoHandlerLogService/ForwardWorkerLogBatches"
+	OttoHandlerLogService_NotifyWorkerStatus_FullMethodName      = "/ottoscaler.v1.OttoHandlerLogService/NotifyWorkerStatus"
 )
 
 // OttoHandlerLogServiceClient is the client API for OttoHandlerLogService service.
@@ -335,7 +493,18 @@ type OttoHandlerLogServiceClient interface {
 	// 2. 로그 발생 시마다 WorkerLogEntry 전송
 	// 3. Otto-handler가 LogForwardResponse로 ACK/RETRY 응답
 	// 4. Worker 작업 완료 시 스트림 종료
+	//
+	// 🔀 멀티플렉싱 모드 (LOG_MULTIPLEX_STREAMS > 0):
+	// - 고정된 수의 장기 스트림이 여러 Worker의 엔트리를 함께 전달
+	// - Worker별 순서는 유지되며, 응답의 worker_id로 대상 Worker를 구분
 	ForwardWorkerLogs(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkerLogEntry, LogForwardResponse], error)
+	// ForwardWorkerLogBatches - Worker Pod 로그를 배치로 전달
+	//
+	// 📝 동작 방식:
+	// - Client (Ottoscaler): 엔트리를 모아 배치 크기에 도달하거나 전송 간격이 지나면 WorkerLogBatch 전송
+	// - Server (Otto-handler): 배치마다 LogForwardResponse로 응답 (sequence = 배치 sequence)
+	// - 로그가 많은 Worker에서 라인마다 메시지를 보내는 ForwardWorkerLogs보다 오버헤드가 적음
+	ForwardWorkerLogBatches(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkerLogBatch, LogForwardResponse], error)
 	// NotifyWorkerStatus - Worker Pod 상태 변경 알림
 	//
 	// 📝 동작 방식:
@@ -365,6 +534,19 @@ func (c *ottoHandlerLogServiceClient) ForwardWorkerLogs(ctx context.Context, opt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OttoHandlerLogService_ForwardWorkerLogsClient = grpc.BidiStreamingClient[WorkerLogEntry, LogForwardResponse]
 
+func (c *ottoHandlerLogServiceClient) ForwardWorkerLogBatches(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkerLogBatch, LogForwardResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OttoHandlerLogService_ServiceDesc.Streams[1], OttoHandlerLogService_ForwardWorkerLogBatches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WorkerLogBatch, LogForwardResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OttoHandlerLogService_ForwardWorkerLogBatchesClient = grpc.BidiStreamingClient[WorkerLogBatch, LogForwardResponse]
+
 func (c *ottoHandlerLogServiceClient) NotifyWorkerStatus(ctx context.Context, in *WorkerStatusNotification, opts ...grpc.CallOption) (*WorkerStatusAck, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WorkerStatusAck)
@@ -401,7 +583,18 @@ type OttoHandlerLogServiceServer interface {
 	// 2. 로그 발생 시마다 WorkerLogEntry 전송
 	// 3. Otto-handler가 LogForwardResponse로 ACK/RETRY 응답
 	// 4. Worker 작업 완료 시 스트림 종료
+	//
+	// 🔀 멀티플렉싱 모드 (LOG_MULTIPLEX_STREAMS > 0):
+	// - 고정된 수의 장기 스트림이 여러 Worker의 엔트리를 함께 전달
+	// - Worker별 순서는 유지되며, 응답의 worker_id로 대상 Worker를 구분
 	ForwardWorkerLogs(grpc.BidiStreamingServer[WorkerLogEntry, LogForwardResponse]) error
+	// ForwardWorkerLogBatches - Worker Pod 로그를 배치로 전달
+	//
+	// 📝 동작 방식:
+	// - Client (Ottoscaler): 엔트리를 모아 배치 크기에 도달하거나 전송 간격이 지나면 WorkerLogBatch 전송
+	// - Server (Otto-handler): 배치마다 LogForwardResponse로 응답 (sequence = 배치 sequence)
+	// - 로그가 많은 Worker에서 라인마다 메시지를 보내는 ForwardWorkerLogs보다 오버헤드가 적음
+	ForwardWorkerLogBatches(grpc.BidiStreamingServer[WorkerLogBatch, LogForwardResponse]) error
 	// NotifyWorkerStatus - Worker Pod 상태 변경 알림
 	//
 	// 📝 동작 방식:
@@ -421,6 +614,9 @@ type UnimplementedOttoHandlerLogServiceServer struct{}
 func (UnimplementedOttoHandlerLogServiceServer) ForwardWorkerLogs(grpc.BidiStreamingServer[WorkerLogEntry, LogForwardResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ForwardWorkerLogs not implemented")
 }
+func (UnimplementedOttoHandlerLogServiceServer) ForwardWorkerLogBatches(grpc.BidiStreamingServer[WorkerLogBatch, LogForwardResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ForwardWorkerLogBatches not implemented")
+}
 func (UnimplementedOttoHandlerLogServiceServer) NotifyWorkerStatus(context.Context, *WorkerStatusNotification) (*WorkerStatusAck, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyWorkerStatus not implemented")
 }
@@ -452,6 +648,13 @@ func _OttoHandlerLogService_ForwardWorkerLogs_Handler(srv interface{}, stream gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OttoHandlerLogService_ForwardWorkerLogsServer = grpc.BidiStreamingServer[WorkerLogEntry, LogForwardResponse]
 
+func _OttoHandlerLogService_ForwardWorkerLogBatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OttoHandlerLogServiceServer).ForwardWorkerLogBatches(&grpc.GenericServerStream[WorkerLogBatch, LogForwardResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OttoHandlerLogService_ForwardWorkerLogBatchesServer = grpc.BidiStreamingServer[WorkerLogBatch, LogForwardResponse]
+
 func _OttoHandlerLogService_NotifyWorkerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkerStatusNotification)
 	if err := dec(in); err != nil {
@@ -489,6 +692,12 @@ var OttoHandlerLogService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ForwardWorkerLogBatches",
+			Handler:       _OttoHandlerLogService_ForwardWorkerLogBatches_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "log_streaming.proto",
 }